@@ -0,0 +1,160 @@
+package common
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// imageVersionRegexp matches the first version in an image name, e.g. `8.10` in `rocky-8.10-base` or `2019` in `win-2019-64-en`
+var imageVersionRegexp = regexp.MustCompile(`\d+(?:\.\d+)*`)
+
+// ImageSortKey is what `most_recent` orders images by.
+// CreateDate is optional since image products do not expose one.
+type ImageSortKey struct {
+	Name       string
+	CreateDate string
+}
+
+func DataSourceNameRegexSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsValidRegExp),
+		Description:      "A regex string to apply to the image name.",
+	}
+}
+
+func DataSourceMostRecentSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "If more than one image matches, use the most recent one by creation date and OS version.",
+	}
+}
+
+// DataSourceNameRegexAttribute is the Plugin Framework variant of DataSourceNameRegexSchema.
+func DataSourceNameRegexAttribute() datasourceschema.Attribute {
+	return datasourceschema.StringAttribute{
+		Optional:    true,
+		Description: "A regex string to apply to the image name.",
+	}
+}
+
+// DataSourceMostRecentAttribute is the Plugin Framework variant of DataSourceMostRecentSchema.
+func DataSourceMostRecentAttribute() datasourceschema.Attribute {
+	return datasourceschema.BoolAttribute{
+		Optional:    true,
+		Description: "Keep only the most recent image by creation date and OS version.",
+	}
+}
+
+// SelectImages keeps the images whose name matches nameRegex, and when mostRecent is set, only the most recent one of them.
+func SelectImages[T any](list []T, nameRegex string, mostRecent bool, key func(T) ImageSortKey) ([]T, error) {
+	if len(nameRegex) > 0 {
+		re, err := regexp.Compile(nameRegex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid name_regex %q: %s", nameRegex, err)
+		}
+
+		var matched []T
+		for _, v := range list {
+			if re.MatchString(key(v).Name) {
+				matched = append(matched, v)
+			}
+		}
+		list = matched
+	}
+
+	if mostRecent && len(list) > 1 {
+		SortImagesMostRecent(list, key)
+		list = list[:1]
+	}
+
+	return list, nil
+}
+
+// SortImagesMostRecent sorts list with the most recent image first.
+// Images are ordered by creation date, then by the OS version parsed from the name, then by name so the order is stable.
+func SortImagesMostRecent[T any](list []T, key func(T) ImageSortKey) {
+	sort.SliceStable(list, func(i, j int) bool {
+		a, b := key(list[i]), key(list[j])
+
+		if c := compareImageCreateDate(a.CreateDate, b.CreateDate); c != 0 {
+			return c > 0
+		}
+
+		if c := CompareImageVersion(ParseImageVersion(a.Name), ParseImageVersion(b.Name)); c != 0 {
+			return c > 0
+		}
+
+		return a.Name > b.Name
+	})
+}
+
+// ParseImageVersion returns the numeric components of the first version found in name, e.g. [22 4] for `ubuntu-22.04`
+func ParseImageVersion(name string) []int {
+	match := imageVersionRegexp.FindString(name)
+	if len(match) == 0 {
+		return nil
+	}
+
+	var version []int
+	for _, p := range strings.Split(match, ".") {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			return version
+		}
+		version = append(version, n)
+	}
+
+	return version
+}
+
+// CompareImageVersion returns 1 if a is newer than b, -1 if older, 0 if equal. Missing components count as 0.
+func CompareImageVersion(a, b []int) int {
+	for i := 0; i < len(a) || i < len(b); i++ {
+		var x, y int
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+
+		if x != y {
+			if x > y {
+				return 1
+			}
+			return -1
+		}
+	}
+
+	return 0
+}
+
+// compareImageCreateDate orders dates that can't be parsed, including empty ones, before every valid date,
+// so images without a creation date are sorted last and the order stays consistent.
+func compareImageCreateDate(a, b string) int {
+	ta, errA := time.Parse("2006-01-02T15:04:05-0700", a)
+	tb, errB := time.Parse("2006-01-02T15:04:05-0700", b)
+
+	switch {
+	case errA != nil && errB != nil:
+		return strings.Compare(a, b)
+	case errA != nil:
+		return -1
+	case errB != nil:
+		return 1
+	}
+
+	return ta.Compare(tb)
+}
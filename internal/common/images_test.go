@@ -0,0 +1,83 @@
+package common
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseImageVersion(t *testing.T) {
+	cases := map[string][]int{
+		"ubuntu-22.04-base": {22, 4},
+		"rocky-8.10-base":   {8, 10},
+		"Rocky Linux 8.8":   {8, 8},
+		"win-2019-64-en":    {2019},
+		"mysql(8.0.36)":     {8, 0, 36},
+		"centos-base":       nil,
+		"":                  nil,
+	}
+
+	for name, expected := range cases {
+		if v := ParseImageVersion(name); !reflect.DeepEqual(v, expected) {
+			t.Fatalf("%s: expected %v, but got %v", name, expected, v)
+		}
+	}
+}
+
+func TestCompareImageVersion(t *testing.T) {
+	cases := []struct {
+		a, b     []int
+		expected int
+	}{
+		{[]int{8, 10}, []int{8, 8}, 1},
+		{[]int{8, 8}, []int{8, 10}, -1},
+		{[]int{22, 4}, []int{22, 4, 0}, 0},
+		{[]int{20, 4}, nil, 1},
+	}
+
+	for _, tc := range cases {
+		if c := CompareImageVersion(tc.a, tc.b); c != tc.expected {
+			t.Fatalf("%v vs %v: expected %d, but got %d", tc.a, tc.b, tc.expected, c)
+		}
+	}
+}
+
+func TestSelectImages(t *testing.T) {
+	images := []ImageSortKey{
+		{Name: "rocky-8.8-base", CreateDate: "2023-06-01T10:00:00+0900"},
+		{Name: "ubuntu-22.04-base", CreateDate: "2024-01-01T10:00:00+0900"},
+		{Name: "rocky-8.10-base", CreateDate: "2024-08-01T10:00:00+0900"},
+		{Name: "rocky-8.9-base", CreateDate: "2024-08-01T10:00:00+0900"},
+	}
+	key := func(k ImageSortKey) ImageSortKey { return k }
+
+	selected, err := SelectImages(append([]ImageSortKey{}, images...), "^rocky-", true, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(selected) != 1 || selected[0].Name != "rocky-8.10-base" {
+		t.Fatalf("expected rocky-8.10-base, but got %v", selected)
+	}
+
+	// without creation dates, the OS version decides
+	products := []ImageSortKey{{Name: "Rocky Linux 8.8"}, {Name: "Rocky Linux 8.10"}, {Name: "Rocky Linux 8.9"}}
+	selected, _ = SelectImages(products, "", true, key)
+	if len(selected) != 1 || selected[0].Name != "Rocky Linux 8.10" {
+		t.Fatalf("expected Rocky Linux 8.10, but got %v", selected)
+	}
+
+	// images without a creation date are sorted last
+	undated := []ImageSortKey{{Name: "rocky-8.11-base"}, images[0], {Name: "rocky-8.12-base"}, images[2]}
+	SortImagesMostRecent(undated, key)
+	if undated[0].Name != "rocky-8.10-base" || undated[1].Name != "rocky-8.8-base" || undated[2].Name != "rocky-8.12-base" {
+		t.Fatalf("expected dated images first, but got %v", undated)
+	}
+
+	selected, _ = SelectImages(append([]ImageSortKey{}, images...), "ubuntu", false, key)
+	if len(selected) != 1 || selected[0].Name != "ubuntu-22.04-base" {
+		t.Fatalf("expected ubuntu-22.04-base, but got %v", selected)
+	}
+
+	if _, err := SelectImages(images, "(", false, key); err == nil {
+		t.Fatal("expected an error for an invalid regex")
+	}
+}
@@ -0,0 +1,18 @@
+package common
+
+import "sync"
+
+// KeyedMutex serializes work per key (e.g. a VPC or a Network ACL number) within the provider process.
+// The zero value is ready to use.
+type KeyedMutex struct {
+	locks sync.Map
+}
+
+// Lock locks key and returns the function that unlocks it.
+func (m *KeyedMutex) Lock(key string) func() {
+	v, _ := m.locks.LoadOrStore(key, &sync.Mutex{})
+	mu := v.(*sync.Mutex)
+	mu.Lock()
+
+	return mu.Unlock
+}
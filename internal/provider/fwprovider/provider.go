@@ -85,6 +85,10 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 	dataSources = append(dataSources, server.NewLoginKeyDataSource)
 	dataSources = append(dataSources, server.NewServerImageNumbersDataSource)
 	dataSources = append(dataSources, server.NewServerSpecsDataSource)
+	dataSources = append(dataSources, server.NewServerSpecSelectorDataSource)
+	dataSources = append(dataSources, server.NewServerDiagnosticsDataSource)
+	dataSources = append(dataSources, server.NewFlowLogsDataSource)
+	dataSources = append(dataSources, server.NewNetworkPathAnalysisDataSource)
 	dataSources = append(dataSources, mysql.NewMysqlDataSource)
 	dataSources = append(dataSources, mysql.NewMysqlImageProductsDataSource)
 	dataSources = append(dataSources, mysql.NewMysqlProductsDataSource)
@@ -132,8 +136,11 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	resources = append(resources, vpc.NewSubnetResource)
 	resources = append(resources, vpc.NewNatGatewayResource)
 	resources = append(resources, vpc.NewVpcPeeringResource)
+	resources = append(resources, vpc.NewVpcPeeringAccepterResource)
 	resources = append(resources, server.NewLoginKeyResource)
 	resources = append(resources, server.NewInitScriptResource)
+	resources = append(resources, server.NewPlacementGroupMembershipResource)
+	resources = append(resources, server.NewFlowLogResource)
 	resources = append(resources, mysql.NewMysqlResource)
 	resources = append(resources, mysql.NewMysqlUsersResource)
 	resources = append(resources, mysql.NewMysqlRecoveryResource)
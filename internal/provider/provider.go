@@ -32,6 +32,7 @@ func New(ctx context.Context) *schema.Provider {
 		"ncloud_auto_scaling_adjustment_types":           autoscaling.DataSourceNcloudAutoScalingAdjustmentTypes(),
 		"ncloud_block_storage":                           server.DataSourceNcloudBlockStorage(),
 		"ncloud_block_storage_snapshot":                  server.DataSourceNcloudBlockStorageSnapshot(),
+		"ncloud_block_storage_snapshots":                 server.DataSourceNcloudBlockStorageSnapshots(),
 		"ncloud_cdss_cluster":                            cdss.DataSourceNcloudCDSSCluster(),
 		"ncloud_cdss_config_group":                       cdss.DataSourceNcloudCDSSConfigGroup(),
 		"ncloud_cdss_kafka_version":                      cdss.DataSourceNcloudCDSSKafkaVersion(),
@@ -98,14 +99,20 @@ func New(ctx context.Context) *schema.Provider {
 
 	resourceMap := map[string]*schema.Resource{
 		"ncloud_access_control_group_rule":           server.ResourceNcloudAccessControlGroupRule(),
+		"ncloud_access_control_group_ingress_rule":   server.ResourceNcloudAccessControlGroupIngressRule(),
+		"ncloud_access_control_group_egress_rule":    server.ResourceNcloudAccessControlGroupEgressRule(),
 		"ncloud_access_control_group":                server.ResourceNcloudAccessControlGroup(),
 		"ncloud_auto_scaling_group":                  autoscaling.ResourceNcloudAutoScalingGroup(),
 		"ncloud_auto_scaling_policy":                 autoscaling.ResourceNcloudAutoScalingPolicy(),
 		"ncloud_auto_scaling_schedule":               autoscaling.ResourceNcloudAutoScalingSchedule(),
 		"ncloud_block_storage_snapshot":              server.ResourceNcloudBlockStorageSnapshot(),
 		"ncloud_block_storage":                       server.ResourceNcloudBlockStorage(),
+		"ncloud_block_storage_attachment":            server.ResourceNcloudBlockStorageAttachment(),
 		"ncloud_cdss_cluster":                        cdss.ResourceNcloudCDSSCluster(),
 		"ncloud_cdss_config_group":                   cdss.ResourceNcloudCDSSConfigGroup(),
+		"ncloud_default_access_control_group":        server.ResourceNcloudDefaultAccessControlGroup(),
+		"ncloud_default_network_acl":                 vpc.ResourceNcloudDefaultNetworkACL(),
+		"ncloud_default_route_table":                 vpc.ResourceNcloudDefaultRouteTable(),
 		"ncloud_launch_configuration":                autoscaling.ResourceNcloudLaunchConfiguration(),
 		"ncloud_lb_listener":                         loadbalancer.ResourceNcloudLbListener(),
 		"ncloud_lb_target_group_attachment":          loadbalancer.ResourceNcloudLbTargetGroupAttachment(),
@@ -114,11 +121,13 @@ func New(ctx context.Context) *schema.Provider {
 		"ncloud_network_acl":                         vpc.ResourceNcloudNetworkACL(),
 		"ncloud_network_acl_deny_allow_group":        vpc.ResourceNcloudNetworkACLDenyAllowGroup(),
 		"ncloud_network_acl_rule":                    vpc.ResourceNcloudNetworkACLRule(),
+		"ncloud_network_acl_entry":                   vpc.ResourceNcloudNetworkACLEntry(),
 		"ncloud_network_interface":                   server.ResourceNcloudNetworkInterface(),
 		"ncloud_nks_cluster":                         nks.ResourceNcloudNKSCluster(),
 		"ncloud_nks_node_pool":                       nks.ResourceNcloudNKSNodePool(),
 		"ncloud_placement_group":                     server.ResourceNcloudPlacementGroup(),
 		"ncloud_public_ip":                           server.ResourceNcloudPublicIpInstance(),
+		"ncloud_public_ip_association":               server.ResourceNcloudPublicIpAssociation(),
 		"ncloud_route":                               vpc.ResourceNcloudRoute(),
 		"ncloud_route_table":                         vpc.ResourceNcloudRouteTable(),
 		"ncloud_route_table_association":             vpc.ResourceNcloudRouteTableAssociation(),
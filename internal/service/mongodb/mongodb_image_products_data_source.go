@@ -39,6 +39,8 @@ func (m *mongodbImageProductsDataSource) Schema(ctx context.Context, req datasou
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
+			"name_regex":  common.DataSourceNameRegexAttribute(),
+			"most_recent": common.DataSourceMostRecentAttribute(),
 			"output_file": schema.StringAttribute{
 				Optional: true,
 			},
@@ -122,6 +124,13 @@ func (m *mongodbImageProductsDataSource) Read(ctx context.Context, req datasourc
 
 	mongodbImageProductList := flattenMongoDbImageProduct(ctx, mongodbImageProductResp.ProductList)
 	fillteredList := common.FilterModels(ctx, data.Filters, mongodbImageProductList)
+	fillteredList, err = common.SelectImages(fillteredList, data.NameRegex.ValueString(), data.MostRecent.ValueBool(), func(v *mongodbImageProduct) common.ImageSortKey {
+		return common.ImageSortKey{Name: v.ProductName.ValueString()}
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
 	data.refreshFromOutput(ctx, fillteredList)
 
 	if !data.OutputFile.IsNull() && data.OutputFile.String() != "" {
@@ -156,6 +165,8 @@ func (m *mongodbImageProductsDataSourceModel) refreshFromOutput(ctx context.Cont
 
 type mongodbImageProductsDataSourceModel struct {
 	ID               types.String `tfsdk:"id"`
+	NameRegex        types.String `tfsdk:"name_regex"`
+	MostRecent       types.Bool   `tfsdk:"most_recent"`
 	ImageProductList types.List   `tfsdk:"image_product_list"`
 	OutputFile       types.String `tfsdk:"output_file"`
 	Filters          types.Set    `tfsdk:"filter"`
@@ -38,6 +38,8 @@ func (m *mssqlImageProductsDataSource) Schema(ctx context.Context, req datasourc
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
+			"name_regex":  common.DataSourceNameRegexAttribute(),
+			"most_recent": common.DataSourceMostRecentAttribute(),
 			"output_file": schema.StringAttribute{
 				Optional: true,
 			},
@@ -118,6 +120,13 @@ func (m *mssqlImageProductsDataSource) Read(ctx context.Context, req datasource.
 
 	mssqlImageProductList := flattenMssqlImageProduct(mssqlImageProductResp.ProductList)
 	fillteredList := common.FilterModels(ctx, data.Filters, mssqlImageProductList)
+	fillteredList, err = common.SelectImages(fillteredList, data.NameRegex.ValueString(), data.MostRecent.ValueBool(), func(v *mssqlImageProduct) common.ImageSortKey {
+		return common.ImageSortKey{Name: v.ProductName.ValueString()}
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
 	data.refreshFromOutput(ctx, fillteredList)
 
 	if !data.OutputFile.IsNull() && data.OutputFile.String() != "" {
@@ -169,6 +178,8 @@ func (m *mssqlImageProductsDataSourceModel) refreshFromOutput(ctx context.Contex
 
 type mssqlImageProductsDataSourceModel struct {
 	ID               types.String `tfsdk:"id"`
+	NameRegex        types.String `tfsdk:"name_regex"`
+	MostRecent       types.Bool   `tfsdk:"most_recent"`
 	ImageProductList types.List   `tfsdk:"image_product_list"`
 	OutputFile       types.String `tfsdk:"output_file"`
 	Filters          types.Set    `tfsdk:"filter"`
@@ -37,6 +37,8 @@ func (m *mysqlImageProductsDataSource) Schema(ctx context.Context, req datasourc
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
+			"name_regex":  common.DataSourceNameRegexAttribute(),
+			"most_recent": common.DataSourceMostRecentAttribute(),
 			"output_file": schema.StringAttribute{
 				Optional: true,
 			},
@@ -120,6 +122,13 @@ func (m *mysqlImageProductsDataSource) Read(ctx context.Context, req datasource.
 
 	mysqlImageProductList := flattenMysqlImageProduct(mysqlImageProductResp.ProductList)
 	fillteredList := common.FilterModels(ctx, data.Filters, mysqlImageProductList)
+	fillteredList, err = common.SelectImages(fillteredList, data.NameRegex.ValueString(), data.MostRecent.ValueBool(), func(v *mysqlImageProduct) common.ImageSortKey {
+		return common.ImageSortKey{Name: v.ProductName.ValueString()}
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
 	data.refreshFromOutput(ctx, fillteredList)
 
 	if !data.OutputFile.IsNull() && data.OutputFile.String() != "" {
@@ -154,6 +163,8 @@ func (m *mysqlImageProductsDataSourceModel) refreshFromOutput(ctx context.Contex
 
 type mysqlImageProductsDataSourceModel struct {
 	ID               types.String `tfsdk:"id"`
+	NameRegex        types.String `tfsdk:"name_regex"`
+	MostRecent       types.Bool   `tfsdk:"most_recent"`
 	ImageProductList types.List   `tfsdk:"image_product_list"`
 	OutputFile       types.String `tfsdk:"output_file"`
 	Filters          types.Set    `tfsdk:"filter"`
@@ -18,7 +18,9 @@ func DataSourceNcloudNKSServerImages() *schema.Resource {
 		Read: dataSourceNcloudNKSServerImagesRead,
 
 		Schema: map[string]*schema.Schema{
-			"filter": DataSourceFiltersSchema(),
+			"filter":      DataSourceFiltersSchema(),
+			"name_regex":  DataSourceNameRegexSchema(),
+			"most_recent": DataSourceMostRecentSchema(),
 			"hypervisor_code": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -55,6 +57,13 @@ func dataSourceNcloudNKSServerImagesRead(d *schema.ResourceData, meta interface{
 		resources = ApplyFilters(f.(*schema.Set), resources, DataSourceNcloudNKSServerImages().Schema["images"].Elem.(*schema.Resource).Schema)
 	}
 
+	resources, err = SelectImages(resources, d.Get("name_regex").(string), d.Get("most_recent").(bool), func(m map[string]interface{}) ImageSortKey {
+		return ImageSortKey{Name: m["label"].(string)}
+	})
+	if err != nil {
+		return err
+	}
+
 	d.SetId(time.Now().UTC().String())
 	if err := d.Set("images", resources); err != nil {
 		return fmt.Errorf("Error setting Codes: %s", err)
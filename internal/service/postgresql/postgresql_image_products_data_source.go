@@ -38,6 +38,8 @@ func (d *postgresqlImageProductsDataSource) Schema(ctx context.Context, req data
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
+			"name_regex":  common.DataSourceNameRegexAttribute(),
+			"most_recent": common.DataSourceMostRecentAttribute(),
 			"output_file": schema.StringAttribute{
 				Optional: true,
 			},
@@ -121,6 +123,13 @@ func (d *postgresqlImageProductsDataSource) Read(ctx context.Context, req dataso
 
 	postgresqlImageProductList := flattenPostgresqlImageProduct(postgresqlImageProductResp.ProductList)
 	fillteredList := common.FilterModels(ctx, data.Filters, postgresqlImageProductList)
+	fillteredList, err = common.SelectImages(fillteredList, data.NameRegex.ValueString(), data.MostRecent.ValueBool(), func(v *postgresqlImageProduct) common.ImageSortKey {
+		return common.ImageSortKey{Name: v.ProductName.ValueString()}
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
 
 	if diags := data.refreshFromOutput(ctx, fillteredList); diags.HasError() {
 		resp.Diagnostics.AddError("READING ERROR", "refreshFromOutput error")
@@ -164,6 +173,8 @@ func (d *postgresqlImageProductsDataSourceModel) refreshFromOutput(ctx context.C
 
 type postgresqlImageProductsDataSourceModel struct {
 	ID               types.String `tfsdk:"id"`
+	NameRegex        types.String `tfsdk:"name_regex"`
+	MostRecent       types.Bool   `tfsdk:"most_recent"`
 	ImageProductList types.List   `tfsdk:"image_product_list"`
 	OutputFile       types.String `tfsdk:"output_file"`
 	Filters          types.Set    `tfsdk:"filter"`
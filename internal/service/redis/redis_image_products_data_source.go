@@ -37,6 +37,8 @@ func (r *redisImageProductsDataSource) Schema(_ context.Context, _ datasource.Sc
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
+			"name_regex":  common.DataSourceNameRegexAttribute(),
+			"most_recent": common.DataSourceMostRecentAttribute(),
 			"output_file": schema.StringAttribute{
 				Optional: true,
 			},
@@ -120,6 +122,13 @@ func (r *redisImageProductsDataSource) Read(ctx context.Context, req datasource.
 
 	redisImageProductList := flattenRedisImageProduct(redisImageProductResp.ProductList)
 	fillteredList := common.FilterModels(ctx, data.Filters, redisImageProductList)
+	fillteredList, err = common.SelectImages(fillteredList, data.NameRegex.ValueString(), data.MostRecent.ValueBool(), func(v *redisImageProduct) common.ImageSortKey {
+		return common.ImageSortKey{Name: v.ProductName.ValueString()}
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
 	data.refreshFromOutput(ctx, fillteredList)
 
 	if !data.OutputFile.IsNull() && data.OutputFile.String() != "" {
@@ -154,6 +163,8 @@ func (r *redisImageProductsDataSourceModel) refreshFromOutput(ctx context.Contex
 
 type redisImageProductsDataSourceModel struct {
 	ID               types.String `tfsdk:"id"`
+	NameRegex        types.String `tfsdk:"name_regex"`
+	MostRecent       types.Bool   `tfsdk:"most_recent"`
 	ImageProductList types.List   `tfsdk:"image_product_list"`
 	OutputFile       types.String `tfsdk:"output_file"`
 	Filters          types.Set    `tfsdk:"filter"`
@@ -0,0 +1,9 @@
+package server
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceNcloudAccessControlGroupEgressRule() *schema.Resource {
+	return resourceNcloudAccessControlGroupSingleRule("outbound")
+}
@@ -0,0 +1,67 @@
+package server_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+)
+
+func TestAccResourceNcloudAccessControlGroupEgressRule_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acg-egress-%s", acctest.RandString(5))
+	resourceName := "ncloud_access_control_group_egress_rule.https"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAccessControlGroupSingleRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudAccessControlGroupEgressRuleConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessControlGroupSingleRuleExists(resourceName, "OTBND"),
+					testAccCheckAccessControlGroupSingleRuleExists("ncloud_access_control_group_egress_rule.dns", "OTBND"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "TCP"),
+					resource.TestCheckResourceAttr(resourceName, "port_range", "443"),
+					resource.TestCheckResourceAttr(resourceName, "ip_block", "0.0.0.0/0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceNcloudAccessControlGroupEgressRuleConfig(name string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "test" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.4.0.0/16"
+}
+
+resource "ncloud_access_control_group" "test" {
+	name                  = "%[1]s"
+	vpc_no                = ncloud_vpc.test.id
+}
+
+resource "ncloud_access_control_group_egress_rule" "https" {
+	access_control_group_no = ncloud_access_control_group.test.id
+	protocol                = "TCP"
+	port_range              = "443"
+	ip_block                = "0.0.0.0/0"
+}
+
+resource "ncloud_access_control_group_egress_rule" "dns" {
+	access_control_group_no = ncloud_access_control_group.test.id
+	protocol                = "UDP"
+	port_range              = "53"
+	ip_block                = "0.0.0.0/0"
+}
+`, name)
+}
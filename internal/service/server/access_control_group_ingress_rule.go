@@ -0,0 +1,286 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
+)
+
+func ResourceNcloudAccessControlGroupIngressRule() *schema.Resource {
+	return resourceNcloudAccessControlGroupSingleRule("inbound")
+}
+
+// resourceNcloudAccessControlGroupSingleRule manages a single inbound or outbound rule of an ACG.
+// The ID is composed of `{access_control_group_no}:{protocol}:{port_range}:{ip_block or source_access_control_group_no}`.
+func resourceNcloudAccessControlGroupSingleRule(ruleType string) *schema.Resource {
+	return &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceNcloudAccessControlGroupSingleRuleCreate(d, meta, ruleType)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceNcloudAccessControlGroupSingleRuleRead(ctx, d, meta, ruleType)
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceNcloudAccessControlGroupSingleRuleDelete(d, meta, ruleType)
+		},
+		Importer: &schema.ResourceImporter{
+			State: resourceNcloudAccessControlGroupSingleRuleImport,
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			return checkAccessControlGroupSingleRuleDeclaredOnce(d, meta.(*conn.ProviderConfig), ruleType)
+		},
+		Schema: map[string]*schema.Schema{
+			"access_control_group_no": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.All(
+					validation.StringMatch(regexp.MustCompile(`TCP|UDP|ICMP|\b([1-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-4])\b`), "only TCP, UDP, ICMP and 1-254 are valid values."),
+					validation.StringNotInSlice([]string{"1", "6", "17"}, false),
+				)),
+			},
+			"port_range": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(ValidatePortRange),
+				Default:          "",
+			},
+			"ip_block": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsCIDRNetwork(0, 32)),
+				ExactlyOneOf:     []string{"ip_block", "source_access_control_group_no"},
+			},
+			"source_access_control_group_no": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"ip_block", "source_access_control_group_no"},
+			},
+			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(0, 1000)),
+				Default:          "",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(conn.DefaultCreateTimeout),
+			Delete: schema.DefaultTimeout(conn.DefaultTimeout),
+		},
+	}
+}
+
+func resourceNcloudAccessControlGroupSingleRuleCreate(d *schema.ResourceData, meta interface{}, ruleType string) error {
+	config := meta.(*conn.ProviderConfig)
+
+	accessControlGroup, err := GetAccessControlGroup(config, d.Get("access_control_group_no").(string))
+	if err != nil {
+		return err
+	}
+
+	if accessControlGroup == nil {
+		return fmt.Errorf("no matching Access Control Group: %s", d.Get("access_control_group_no"))
+	}
+
+	m := accessControlGroupSingleRuleMap(d)
+	rules, err := expandAddAccessControlGroupRule([]interface{}{m})
+	if err != nil {
+		return err
+	}
+
+	if err := addAccessControlGroupRule(d, config, ruleType, accessControlGroup, rules); err != nil {
+		return err
+	}
+
+	d.SetId(accessControlGroupSingleRuleId(m))
+	log.Printf("[INFO] ACG %s rule ID: %s", ruleType, d.Id())
+
+	_, err = readAccessControlGroupSingleRule(d, config, ruleType)
+	return err
+}
+
+// resourceNcloudAccessControlGroupSingleRuleRead warns when the rule disappeared from an ACG that still exists,
+// which is what happens on every apply when the ACG is also managed by an authoritative resource.
+func resourceNcloudAccessControlGroupSingleRuleRead(_ context.Context, d *schema.ResourceData, meta interface{}, ruleType string) diag.Diagnostics {
+	id := d.Id()
+
+	removed, err := readAccessControlGroupSingleRule(d, meta.(*conn.ProviderConfig), ruleType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if removed {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("ACG %s rule was removed outside of this resource", ruleType),
+			Detail: fmt.Sprintf("The rule (%s) is no longer in ACG (%s) and will be created again. "+
+				"If the ACG is also managed by ncloud_access_control_group_rule or ncloud_default_access_control_group, "+
+				"they remove this rule on every apply. Manage the rules of an ACG with only one kind of resource.", id, d.Get("access_control_group_no")),
+		}}
+	}
+
+	return nil
+}
+
+// readAccessControlGroupSingleRule refreshes d from the API. removed is true when the ACG exists but the rule doesn't.
+func readAccessControlGroupSingleRule(d *schema.ResourceData, config *conn.ProviderConfig, ruleType string) (removed bool, err error) {
+	rule, err := getAccessControlGroupSingleRule(config, ruleType, accessControlGroupSingleRuleMap(d))
+	if err != nil {
+		errBody, _ := GetCommonErrorBody(err)
+		if errBody.ReturnCode == "1007000" { // Acg was not found
+			d.SetId("")
+			return false, nil
+		}
+		return false, err
+	}
+
+	if rule == nil {
+		log.Printf("[WARN] ACG %s rule (%s) not found, removing from state", ruleType, d.Id())
+		d.SetId("")
+		return true, nil
+	}
+
+	d.Set("access_control_group_no", rule.AccessControlGroupNo)
+	d.Set("protocol", flattenAccessControlGroupRuleProtocol(rule))
+	d.Set("port_range", rule.PortRange)
+	d.Set("description", rule.AccessControlGroupRuleDescription)
+
+	if len(ncloud.StringValue(rule.AccessControlGroupSequence)) > 0 {
+		d.Set("source_access_control_group_no", rule.AccessControlGroupSequence)
+	} else {
+		d.Set("ip_block", rule.IpBlock)
+	}
+
+	return false, nil
+}
+
+// checkAccessControlGroupSingleRuleDeclaredOnce rejects a new rule that the ACG already has with the same description,
+// e.g. because it is also declared in ncloud_access_control_group_rule.
+func checkAccessControlGroupSingleRuleDeclaredOnce(d *schema.ResourceDiff, config *conn.ProviderConfig, ruleType string) error {
+	if d.Id() != "" {
+		return nil
+	}
+
+	for _, k := range []string{"access_control_group_no", "protocol", "port_range", "ip_block", "source_access_control_group_no", "description"} {
+		if !d.NewValueKnown(k) {
+			return nil
+		}
+	}
+
+	m := accessControlGroupSingleRuleMap(d)
+	rule, err := getAccessControlGroupSingleRule(config, ruleType, m)
+	if err != nil {
+		errBody, _ := GetCommonErrorBody(err)
+		if errBody.ReturnCode == "1007000" { // Acg was not found
+			return nil
+		}
+		return err
+	}
+
+	if rule != nil && ncloud.StringValue(rule.AccessControlGroupRuleDescription) == m["description"].(string) {
+		return fmt.Errorf("ACG (%s) already has the %s rule %s. It is probably also declared in ncloud_access_control_group_rule, "+
+			"ncloud_default_access_control_group or another rule resource. Manage the rules of an ACG with only one kind of resource",
+			m["access_control_group_no"], ruleType, accessControlGroupSingleRuleId(m))
+	}
+
+	return nil
+}
+
+func resourceNcloudAccessControlGroupSingleRuleDelete(d *schema.ResourceData, meta interface{}, ruleType string) error {
+	config := meta.(*conn.ProviderConfig)
+
+	accessControlGroup, err := GetAccessControlGroup(config, d.Get("access_control_group_no").(string))
+	if err != nil {
+		return err
+	}
+
+	if accessControlGroup == nil {
+		return nil
+	}
+
+	rules := expandRemoveAccessControlGroupRule([]interface{}{accessControlGroupSingleRuleMap(d)})
+
+	return removeAccessControlGroupRule(d, config, ruleType, accessControlGroup, rules)
+}
+
+func resourceNcloudAccessControlGroupSingleRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), ":")
+	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[3] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected access_control_group_no:protocol:port_range:ip_block_or_source_access_control_group_no", d.Id())
+	}
+
+	d.Set("access_control_group_no", parts[0])
+	d.Set("protocol", parts[1])
+	d.Set("port_range", parts[2])
+
+	if strings.Contains(parts[3], "/") {
+		d.Set("ip_block", parts[3])
+	} else {
+		d.Set("source_access_control_group_no", parts[3])
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func accessControlGroupSingleRuleMap(d interface{ Get(string) interface{} }) map[string]interface{} {
+	return map[string]interface{}{
+		"access_control_group_no":        d.Get("access_control_group_no").(string),
+		"protocol":                       d.Get("protocol").(string),
+		"port_range":                     d.Get("port_range").(string),
+		"ip_block":                       d.Get("ip_block").(string),
+		"source_access_control_group_no": d.Get("source_access_control_group_no").(string),
+		"description":                    d.Get("description").(string),
+	}
+}
+
+func accessControlGroupSingleRuleId(m map[string]interface{}) string {
+	target := m["ip_block"].(string)
+	if len(target) == 0 {
+		target = m["source_access_control_group_no"].(string)
+	}
+
+	return fmt.Sprintf("%s:%s:%s:%s", m["access_control_group_no"], m["protocol"], m["port_range"], target)
+}
+
+func getAccessControlGroupSingleRule(config *conn.ProviderConfig, ruleType string, m map[string]interface{}) (*vserver.AccessControlGroupRule, error) {
+	rules, err := GetAccessControlGroupRuleList(config, m["access_control_group_no"].(string))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range rules {
+		if (*r.AccessControlGroupRuleType.Code == "INBND") != (ruleType == "inbound") {
+			continue
+		}
+
+		if flattenAccessControlGroupRuleProtocol(r) == m["protocol"].(string) &&
+			ncloud.StringValue(r.PortRange) == m["port_range"].(string) &&
+			ncloud.StringValue(r.IpBlock) == m["ip_block"].(string) &&
+			ncloud.StringValue(r.AccessControlGroupSequence) == m["source_access_control_group_no"].(string) {
+			return r, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,139 @@
+package server_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/server"
+)
+
+func TestAccResourceNcloudAccessControlGroupIngressRule_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acg-ingress-%s", acctest.RandString(5))
+	resourceName := "ncloud_access_control_group_ingress_rule.ssh"
+	sourceResourceName := "ncloud_access_control_group_ingress_rule.from_bastion"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAccessControlGroupSingleRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudAccessControlGroupIngressRuleConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessControlGroupSingleRuleExists(resourceName, "INBND"),
+					testAccCheckAccessControlGroupSingleRuleExists(sourceResourceName, "INBND"),
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(`^\d+:TCP:22:10\.0\.0\.0/8$`)),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "TCP"),
+					resource.TestCheckResourceAttr(resourceName, "port_range", "22"),
+					resource.TestCheckResourceAttr(resourceName, "ip_block", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr(resourceName, "description", name),
+					resource.TestCheckResourceAttrPair(sourceResourceName, "source_access_control_group_no", "ncloud_access_control_group.bastion", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      sourceResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceNcloudAccessControlGroupIngressRuleConfig(name string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "test" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.4.0.0/16"
+}
+
+resource "ncloud_access_control_group" "app" {
+	name                  = "%[1]s-app"
+	vpc_no                = ncloud_vpc.test.id
+}
+
+resource "ncloud_access_control_group" "bastion" {
+	name                  = "%[1]s-bastion"
+	vpc_no                = ncloud_vpc.test.id
+}
+
+resource "ncloud_access_control_group_ingress_rule" "ssh" {
+	access_control_group_no = ncloud_access_control_group.app.id
+	protocol                = "TCP"
+	port_range              = "22"
+	ip_block                = "10.0.0.0/8"
+	description             = "%[1]s"
+}
+
+resource "ncloud_access_control_group_ingress_rule" "from_bastion" {
+	access_control_group_no        = ncloud_access_control_group.app.id
+	protocol                       = "TCP"
+	port_range                     = "8080"
+	source_access_control_group_no = ncloud_access_control_group.bastion.id
+}
+`, name)
+}
+
+func testAccCheckAccessControlGroupSingleRuleExists(n string, ruleType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no Access Control Group rule id is set")
+		}
+
+		config := TestAccProvider.Meta().(*conn.ProviderConfig)
+
+		rules, err := server.GetAccessControlGroupRuleList(config, rs.Primary.Attributes["access_control_group_no"])
+		if err != nil {
+			return err
+		}
+
+		for _, r := range rules {
+			if *r.AccessControlGroupRuleType.Code == ruleType &&
+				ncloud.StringValue(r.PortRange) == rs.Primary.Attributes["port_range"] &&
+				ncloud.StringValue(r.IpBlock) == rs.Primary.Attributes["ip_block"] &&
+				ncloud.StringValue(r.AccessControlGroupSequence) == rs.Primary.Attributes["source_access_control_group_no"] {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("rule not found: %s", rs.Primary.ID)
+	}
+}
+
+func testAccCheckAccessControlGroupSingleRuleDestroy(s *terraform.State) error {
+	config := TestAccProvider.Meta().(*conn.ProviderConfig)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ncloud_access_control_group_ingress_rule" && rs.Type != "ncloud_access_control_group_egress_rule" {
+			continue
+		}
+
+		instance, err := server.GetAccessControlGroup(config, rs.Primary.Attributes["access_control_group_no"])
+		if err != nil {
+			return err
+		}
+
+		if instance != nil {
+			return fmt.Errorf("Access Control Group still exists")
+		}
+	}
+
+	return nil
+}
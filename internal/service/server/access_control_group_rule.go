@@ -185,20 +185,28 @@ func resourceNcloudAccessControlGroupRuleRead(d *schema.ResourceData, meta inter
 
 	d.Set("access_control_group_no", d.Id())
 
-	// Create empty set for getAccessControlGroupRuleList
-	iSet := schema.NewSet(schema.HashResource(ResourceNcloudAccessControlGroupRule().Schema["inbound"].Elem.(*schema.Resource)), []interface{}{})
-	oSet := schema.NewSet(schema.HashResource(ResourceNcloudAccessControlGroupRule().Schema["outbound"].Elem.(*schema.Resource)), []interface{}{})
+	iSet, oSet := flattenAccessControlGroupRules(rules)
 
-	for _, r := range rules {
-		var protocol string
-		if allowedProtocolCodes[*r.ProtocolType.Code] {
-			protocol = *r.ProtocolType.Code
-		} else {
-			protocol = strconv.Itoa(int(*r.ProtocolType.Number))
-		}
+	// Only set data intersection between resource and list
+	if err := d.Set("inbound", iSet.List()); err != nil {
+		log.Printf("[WARN] Error setting inbound rule set for (%s): %s", d.Id(), err)
+	}
 
+	if err := d.Set("outbound", oSet.List()); err != nil {
+		log.Printf("[WARN] Error setting outbound rule set for (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// flattenAccessControlGroupRules splits rules into inbound and outbound sets hashed like the `inbound`/`outbound` blocks.
+func flattenAccessControlGroupRules(rules []*vserver.AccessControlGroupRule) (*schema.Set, *schema.Set) {
+	iSet := schema.NewSet(accessControlGroupRuleHash, []interface{}{})
+	oSet := schema.NewSet(accessControlGroupRuleHash, []interface{}{})
+
+	for _, r := range rules {
 		m := map[string]interface{}{
-			"protocol":                       protocol,
+			"protocol":                       flattenAccessControlGroupRuleProtocol(r),
 			"port_range":                     *r.PortRange,
 			"ip_block":                       *r.IpBlock,
 			"source_access_control_group_no": *r.AccessControlGroupSequence,
@@ -212,16 +220,11 @@ func resourceNcloudAccessControlGroupRuleRead(d *schema.ResourceData, meta inter
 		}
 	}
 
-	// Only set data intersection between resource and list
-	if err := d.Set("inbound", iSet.List()); err != nil {
-		log.Printf("[WARN] Error setting inbound rule set for (%s): %s", d.Id(), err)
-	}
-
-	if err := d.Set("outbound", oSet.List()); err != nil {
-		log.Printf("[WARN] Error setting outbound rule set for (%s): %s", d.Id(), err)
-	}
+	return iSet, oSet
+}
 
-	return nil
+func accessControlGroupRuleHash(v interface{}) int {
+	return schema.HashResource(ResourceNcloudAccessControlGroupRule().Schema["inbound"].Elem.(*schema.Resource))(v)
 }
 
 func resourceNcloudAccessControlGroupRuleUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -339,6 +342,9 @@ func addAccessControlGroupRule(d *schema.ResourceData, config *conn.ProviderConf
 	var reqParams interface{}
 	var resp interface{}
 
+	unlock := accessControlGroupLocks.Lock(*accessControlGroup.AccessControlGroupNo)
+	defer unlock()
+
 	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		var err error
 
@@ -346,7 +352,7 @@ func addAccessControlGroupRule(d *schema.ResourceData, config *conn.ProviderConf
 		if ruleType == "inbound" {
 			reqParams = &vserver.AddAccessControlGroupInboundRuleRequest{
 				RegionCode:                 &config.RegionCode,
-				AccessControlGroupNo:       ncloud.String(d.Id()),
+				AccessControlGroupNo:       accessControlGroup.AccessControlGroupNo,
 				VpcNo:                      accessControlGroup.VpcNo,
 				AccessControlGroupRuleList: accessControlGroupRule,
 			}
@@ -356,7 +362,7 @@ func addAccessControlGroupRule(d *schema.ResourceData, config *conn.ProviderConf
 		} else {
 			reqParams = &vserver.AddAccessControlGroupOutboundRuleRequest{
 				RegionCode:                 &config.RegionCode,
-				AccessControlGroupNo:       ncloud.String(d.Id()),
+				AccessControlGroupNo:       accessControlGroup.AccessControlGroupNo,
 				VpcNo:                      accessControlGroup.VpcNo,
 				AccessControlGroupRuleList: accessControlGroupRule,
 			}
@@ -384,7 +390,7 @@ func addAccessControlGroupRule(d *schema.ResourceData, config *conn.ProviderConf
 
 	LogResponse("AddAccessControlGroupRule", resp)
 
-	if err = waitForVpcAccessControlGroupRunning(config, d.Id()); err != nil {
+	if err = waitForVpcAccessControlGroupRunning(config, *accessControlGroup.AccessControlGroupNo); err != nil {
 		return err
 	}
 
@@ -395,6 +401,9 @@ func removeAccessControlGroupRule(d *schema.ResourceData, config *conn.ProviderC
 	var reqParams interface{}
 	var resp interface{}
 
+	unlock := accessControlGroupLocks.Lock(*accessControlGroup.AccessControlGroupNo)
+	defer unlock()
+
 	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		var err error
 
@@ -402,7 +411,7 @@ func removeAccessControlGroupRule(d *schema.ResourceData, config *conn.ProviderC
 		if ruleType == "inbound" {
 			reqParams = &vserver.RemoveAccessControlGroupInboundRuleRequest{
 				RegionCode:                 &config.RegionCode,
-				AccessControlGroupNo:       ncloud.String(d.Id()),
+				AccessControlGroupNo:       accessControlGroup.AccessControlGroupNo,
 				VpcNo:                      accessControlGroup.VpcNo,
 				AccessControlGroupRuleList: accessControlGroupRule,
 			}
@@ -412,7 +421,7 @@ func removeAccessControlGroupRule(d *schema.ResourceData, config *conn.ProviderC
 		} else {
 			reqParams = &vserver.RemoveAccessControlGroupOutboundRuleRequest{
 				RegionCode:                 &config.RegionCode,
-				AccessControlGroupNo:       ncloud.String(d.Id()),
+				AccessControlGroupNo:       accessControlGroup.AccessControlGroupNo,
 				VpcNo:                      accessControlGroup.VpcNo,
 				AccessControlGroupRuleList: accessControlGroupRule,
 			}
@@ -440,7 +449,7 @@ func removeAccessControlGroupRule(d *schema.ResourceData, config *conn.ProviderC
 
 	LogResponse("RemoveAccessControlGroupRule", resp)
 
-	if err = waitForVpcAccessControlGroupRunning(config, d.Id()); err != nil {
+	if err = waitForVpcAccessControlGroupRunning(config, *accessControlGroup.AccessControlGroupNo); err != nil {
 		return err
 	}
 
@@ -521,3 +530,15 @@ var allowedProtocolCodes = map[string]bool{
 	"UDP":  true,
 	"ICMP": true,
 }
+
+func flattenAccessControlGroupRuleProtocol(r *vserver.AccessControlGroupRule) string {
+	if allowedProtocolCodes[*r.ProtocolType.Code] {
+		return *r.ProtocolType.Code
+	}
+
+	return strconv.Itoa(int(*r.ProtocolType.Number))
+}
+
+// accessControlGroupLocks serializes rule changes per ACG within the provider process
+// to avoid ApiErrorAcgCantChangeSameTime as far as possible.
+var accessControlGroupLocks KeyedMutex
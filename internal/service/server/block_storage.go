@@ -44,7 +44,7 @@ func ResourceNcloudBlockStorage() *schema.Resource {
 		Update: resourceNcloudBlockStorageUpdate,
 		Delete: resourceNcloudBlockStorageDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceNcloudBlockStorageImport,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -53,9 +53,11 @@ func ResourceNcloudBlockStorage() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			// Computed, so that an attachment made by ncloud_block_storage_attachment is not undone when this is omitted
 			"server_instance_no": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
 			"size": {
 				Type:             schema.TypeInt,
@@ -99,6 +101,7 @@ func ResourceNcloudBlockStorage() *schema.Resource {
 				Optional:         true,
 				Computed:         true,
 				ForceNew:         true,
+				Description:      "The vserver API has no operation to change the volume type of an existing block storage, so changing it forces a new resource.",
 				ConflictsWith:    []string{"disk_detail_type"},
 				RequiredWith:     []string{"hypervisor_type"},
 				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{BlockStorageVolumeTypeHdd, BlockStorageVolumeTypeSsd, BlockStorageVolumeTypeFb1, BlockStorageVolumeTypeCb1}, false)),
@@ -153,8 +156,18 @@ func ResourceNcloudBlockStorage() *schema.Resource {
 				Computed: true,
 			},
 			"max_iops": {
-				Type:     schema.TypeInt,
-				Computed: true,
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Description:      "Provisioned IOPS. Only `KVM` block storages can change it.",
+			},
+			"throughput": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Description:      "Provisioned throughput(MB/s). Only `KVM` block storages can change it.",
 			},
 			"encrypted_volume": {
 				Type:     schema.TypeBool,
@@ -167,8 +180,13 @@ func ResourceNcloudBlockStorage() *schema.Resource {
 func resourceNcloudBlockStorageCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*conn.ProviderConfig)
 
-	if len(d.Get("server_instance_no").(string)) == 0 {
-		return fmt.Errorf("'server_instance_no' has to be present when ncloud_block_storage is first created.")
+	// KVM block storages can be created detached and attached later, e.g. with ncloud_block_storage_attachment
+	if len(d.Get("server_instance_no").(string)) == 0 && d.Get("hypervisor_type").(string) != BlockStorageHypervisorTypeKvm {
+		return fmt.Errorf("'server_instance_no' has to be present when ncloud_block_storage is first created, unless 'hypervisor_type' is %s.", BlockStorageHypervisorTypeKvm)
+	}
+
+	if hasBlockStoragePerformanceConfig(d) && d.Get("hypervisor_type").(string) != BlockStorageHypervisorTypeKvm {
+		return fmt.Errorf("`max_iops` and `throughput` can only be set on `%s` block storages", BlockStorageHypervisorTypeKvm)
 	}
 
 	id, err := createBlockStorage(d, config)
@@ -206,10 +224,27 @@ func resourceNcloudBlockStorageRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
+func resourceNcloudBlockStorageImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*conn.ProviderConfig)
+
+	r, err := GetBlockStorage(config, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if r == nil {
+		return nil, fmt.Errorf("no matching Block Storage: %s", d.Id())
+	}
+
+	d.Set("server_instance_no", r.ServerInstanceNo)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceNcloudBlockStorageDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*conn.ProviderConfig)
 
-	if d.Get("stop_instance_before_detaching").(bool) {
+	if d.Get("stop_instance_before_detaching").(bool) && len(d.Get("server_instance_no").(string)) > 0 {
 		log.Printf("[INFO] Stopping Instance %s for destroying block storage", d.Get("server_instance_no").(string))
 		if err := stopThenWaitServerInstance(config, d.Get("server_instance_no").(string)); err != nil {
 			return err
@@ -230,8 +265,18 @@ func resourceNcloudBlockStorageUpdate(d *schema.ResourceData, meta interface{})
 	if d.HasChange("server_instance_no") {
 		o, n := d.GetChange("server_instance_no")
 
+		// The block storage may already have been moved, e.g. released by a replaced server with `preserve_on_replace`
+		current, err := GetBlockStorage(config, d.Id())
+		if err != nil {
+			return err
+		}
+		currentServerInstanceNo := ""
+		if current != nil {
+			currentServerInstanceNo = ncloud.StringValue(current.ServerInstanceNo)
+		}
+
 		// If server instance attached block storage, detach first
-		if len(o.(string)) > 0 {
+		if len(o.(string)) > 0 && currentServerInstanceNo == o.(string) {
 			if d.Get("stop_instance_before_detaching").(bool) {
 				log.Printf("[INFO] Start Instance %s after detaching block storage", o.(string))
 				if err := stopThenWaitServerInstance(config, o.(string)); err != nil {
@@ -248,46 +293,30 @@ func resourceNcloudBlockStorageUpdate(d *schema.ResourceData, meta interface{})
 			}
 		}
 
-		if len(n.(string)) > 0 {
+		if len(n.(string)) > 0 && currentServerInstanceNo != n.(string) {
 			if err := attachBlockStorage(d, config); err != nil {
 				return err
 			}
 		}
 	}
 
-	if d.HasChange("size") {
-		o, n := d.GetChange("size")
-
-		if o.(int) >= n.(int) {
-			return fmt.Errorf("The storage size is only expandable, not shrinking. new size(%d) must be greater than the existing size(%d)", n, o)
-		}
-
-		// If server instance attached block storage, detach first
-		if len(d.Get("server_instance_no").(string)) > 0 {
-			if d.Get("stop_instance_before_detaching").(bool) {
-				log.Printf("[INFO] Start Instance %s after detaching block storage", d.Get("server_instance_no").(string))
-				if err := stopThenWaitServerInstance(config, d.Get("server_instance_no").(string)); err != nil {
-					return err
-				}
-			}
-
-			if err := detachBlockStorage(config, d.Id()); err != nil {
-				return err
-			}
+	if d.HasChanges("size", "max_iops", "throughput") {
+		if d.HasChange("size") {
+			o, n := d.GetChange("size")
 
-			if err := detachThenWaitServerInstance(config, d.Get("server_instance_no").(string)); err != nil {
-				return err
+			if o.(int) >= n.(int) {
+				return fmt.Errorf("The storage size is only expandable, not shrinking. new size(%d) must be greater than the existing size(%d)", n, o)
 			}
 		}
 
-		if err := changeBlockStorageSize(d, config); err != nil {
-			return err
+		if d.HasChanges("max_iops", "throughput") && d.Get("hypervisor_type").(string) != BlockStorageHypervisorTypeKvm {
+			return fmt.Errorf("`max_iops` and `throughput` can only be changed on `%s` block storages", BlockStorageHypervisorTypeKvm)
 		}
 
-		if len(d.Get("server_instance_no").(string)) > 0 {
-			if err := attachBlockStorage(d, config); err != nil {
-				return err
-			}
+		if err := detachBlockStorageDuring(d, config, d.Get("server_instance_no").(string), func() error {
+			return changeBlockStorage(d, config)
+		}); err != nil {
+			return err
 		}
 	}
 
@@ -340,8 +369,11 @@ func createBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig) (*s
 			LogErrorResponse("createVpcBlockStorage", err, reqParams)
 			return nil, err
 		}
+	}
 
-		server, err := GetServerInstance(config, d.Get("server_instance_no").(string))
+	if serverInstanceNo := d.Get("server_instance_no").(string); hypervisorType == BlockStorageHypervisorTypeKvm && len(serverInstanceNo) > 0 {
+		zone := d.Get("zone").(string)
+		server, err := GetServerInstance(config, serverInstanceNo)
 		if err == nil && server == nil {
 			err = fmt.Errorf("fail to get serverInstance")
 		}
@@ -379,8 +411,16 @@ func createBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig) (*s
 		return nil, err
 	}
 
-	if *output.StatusName == BlockStorageStatusNameDetach {
-		d.SetId(*instance.BlockStorageInstanceNo)
+	d.SetId(*instance.BlockStorageInstanceNo)
+
+	// IOPS and throughput can't be given on creation, so they are changed before the block storage is attached
+	if hasBlockStoragePerformanceConfig(d) && blockStoragePerformanceDiffers(d, output) {
+		if err := changeBlockStorage(d, config); err != nil {
+			return nil, err
+		}
+	}
+
+	if *output.StatusName == BlockStorageStatusNameDetach && len(d.Get("server_instance_no").(string)) > 0 {
 		if err := attachBlockStorage(d, config); err != nil {
 			return nil, err
 		}
@@ -423,6 +463,7 @@ func GetBlockStorage(config *conn.ProviderConfig, id string) (*BlockStorage, err
 			DiskDetailType:          common.GetCodePtrByCommonCode(inst.BlockStorageDiskDetailType),
 			ZoneCode:                inst.ZoneCode,
 			MaxIops:                 inst.MaxIopsThroughput,
+			Throughput:              inst.Throughput,
 			EncryptedVolume:         inst.IsEncryptedVolume,
 			ReturnProtection:        inst.IsReturnProtection,
 			VolumeType:              common.GetCodePtrByCommonCode(inst.BlockStorageVolumeType),
@@ -633,7 +674,58 @@ func waitForBlockStorageAttachment(config *conn.ProviderConfig, id string) error
 	return nil
 }
 
-func changeBlockStorageSize(d *schema.ResourceData, config *conn.ProviderConfig) error {
+// detachBlockStorageDuring detaches the block storage from serverInstanceNo, if any, runs fn and attaches it again,
+// also when fn fails. With `stop_instance_before_detaching`, a running server is stopped beforehand and started again afterwards.
+func detachBlockStorageDuring(d *schema.ResourceData, config *conn.ProviderConfig, serverInstanceNo string, fn func() error) error {
+	if len(serverInstanceNo) == 0 {
+		return fn()
+	}
+
+	detachDuring := func() error {
+		if err := detachBlockStorage(config, d.Id()); err != nil {
+			return err
+		}
+
+		if err := detachThenWaitServerInstance(config, serverInstanceNo); err != nil {
+			return err
+		}
+
+		if err := fn(); err != nil {
+			if attachErr := attachBlockStorage(d, config); attachErr != nil {
+				log.Printf("[ERROR] Fail to attach Block Storage %s again after failed change: %s", d.Id(), attachErr)
+			}
+			return err
+		}
+
+		return attachBlockStorage(d, config)
+	}
+
+	if d.Get("stop_instance_before_detaching").(bool) {
+		return stopServerInstanceDuring(config, serverInstanceNo, "block storage", detachDuring)
+	}
+
+	return detachDuring()
+}
+
+func hasBlockStoragePerformanceConfig(d *schema.ResourceData) bool {
+	_, iopsOk := d.GetOk("max_iops")
+	_, throughputOk := d.GetOk("throughput")
+	return iopsOk || throughputOk
+}
+
+func blockStoragePerformanceDiffers(d *schema.ResourceData, r *BlockStorage) bool {
+	if v, ok := d.GetOk("max_iops"); ok && int32(v.(int)) != ncloud.Int32Value(r.MaxIops) {
+		return true
+	}
+
+	if v, ok := d.GetOk("throughput"); ok && int64(v.(int)) != ncloud.Int64Value(r.Throughput) {
+		return true
+	}
+
+	return false
+}
+
+func changeBlockStorage(d *schema.ResourceData, config *conn.ProviderConfig) error {
 	var err error
 	if d.Get("hypervisor_type").(string) == BlockStorageHypervisorTypeXen {
 		err = changeVpcBlockStorageVolumeSize(d, config)
@@ -674,7 +766,19 @@ func changeVpcBlockStorageInstance(d *schema.ResourceData, config *conn.Provider
 	reqParams := &vserver.ChangeBlockStorageInstanceRequest{
 		RegionCode:             &config.RegionCode,
 		BlockStorageInstanceNo: ncloud.String(d.Id()),
-		BlockStorageSize:       ncloud.Int32(int32(d.Get("size").(int))),
+	}
+
+	// Only send what changed, creation applies the configured performance without a size change
+	if d.HasChange("size") && !d.IsNewResource() {
+		reqParams.BlockStorageSize = ncloud.Int32(int32(d.Get("size").(int)))
+	}
+
+	if v, ok := d.GetOk("max_iops"); ok && (d.HasChange("max_iops") || d.IsNewResource()) {
+		reqParams.Iops = ncloud.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("throughput"); ok && (d.HasChange("throughput") || d.IsNewResource()) {
+		reqParams.Throughput = ncloud.Int32(int32(v.(int)))
 	}
 
 	LogCommonRequest("changeVpcBlockStorageInstance", reqParams)
@@ -752,6 +856,7 @@ type BlockStorage struct {
 	DiskDetailType          *string `json:"disk_detail_type,omitempty"`
 	ZoneCode                *string `json:"zone,omitempty"`
 	MaxIops                 *int32  `json:"max_iops,omitempty"`
+	Throughput              *int64  `json:"throughput,omitempty"`
 	EncryptedVolume         *bool   `json:"encrypted_volume,omitempty"`
 	ReturnProtection        *bool   `json:"return_protection,omitempty"`
 	VolumeType              *string `json:"volume_type,omitempty"`
@@ -0,0 +1,185 @@
+package server
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+func ResourceNcloudBlockStorageAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNcloudBlockStorageAttachmentCreate,
+		Read:   resourceNcloudBlockStorageAttachmentRead,
+		Update: resourceNcloudBlockStorageAttachmentUpdate,
+		Delete: resourceNcloudBlockStorageAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(conn.DefaultCreateTimeout),
+			Update: schema.DefaultTimeout(conn.DefaultUpdateTimeout),
+			Delete: schema.DefaultTimeout(conn.DefaultTimeout),
+		},
+		Schema: map[string]*schema.Schema{
+			"block_storage_no": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"server_instance_no": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"stop_instance_before_detaching": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"force_detach": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Stop the server and retry when detaching fails, e.g. because the volume is in use.",
+			},
+			"device_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNcloudBlockStorageAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+	blockStorageNo := d.Get("block_storage_no").(string)
+
+	r, err := GetBlockStorage(config, blockStorageNo)
+	if err != nil {
+		return err
+	}
+
+	if r == nil {
+		return fmt.Errorf("no matching Block Storage: %s", blockStorageNo)
+	}
+
+	d.SetId(blockStorageNo)
+
+	if current := ncloud.StringValue(r.ServerInstanceNo); len(current) > 0 {
+		if current == d.Get("server_instance_no").(string) {
+			log.Printf("[INFO] Block Storage %s is already attached to server instance %s", blockStorageNo, current)
+			return resourceNcloudBlockStorageAttachmentRead(d, meta)
+		}
+
+		d.SetId("")
+		return fmt.Errorf("Block Storage %s is already attached to server instance %s", blockStorageNo, current)
+	}
+
+	if err := attachBlockStorage(d, config); err != nil {
+		d.SetId("")
+		return err
+	}
+
+	log.Printf("[INFO] Block Storage Attachment ID: %s", d.Id())
+
+	return resourceNcloudBlockStorageAttachmentRead(d, meta)
+}
+
+func resourceNcloudBlockStorageAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+
+	r, err := GetBlockStorage(config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if r == nil || len(ncloud.StringValue(r.ServerInstanceNo)) == 0 {
+		log.Printf("[WARN] Block Storage Attachment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("block_storage_no", r.BlockStorageInstanceNo)
+	d.Set("server_instance_no", r.ServerInstanceNo)
+	d.Set("device_name", r.DeviceName)
+
+	return nil
+}
+
+func resourceNcloudBlockStorageAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+
+	if d.HasChange("server_instance_no") {
+		o, n := d.GetChange("server_instance_no")
+
+		// The old server may already have released the block storage, e.g. a replaced server with `preserve_on_replace`,
+		// or still hold it, e.g. with `create_before_destroy`
+		r, err := GetBlockStorage(config, d.Id())
+		if err != nil {
+			return err
+		}
+
+		if r == nil {
+			return fmt.Errorf("no matching Block Storage: %s", d.Id())
+		}
+
+		current := ncloud.StringValue(r.ServerInstanceNo)
+		if len(current) > 0 && current == o.(string) {
+			if err := detachBlockStorageFromServer(d, config, o.(string)); err != nil {
+				return err
+			}
+		}
+
+		if current != n.(string) {
+			if err := attachBlockStorage(d, config); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceNcloudBlockStorageAttachmentRead(d, meta)
+}
+
+func resourceNcloudBlockStorageAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+
+	r, err := GetBlockStorage(config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Leave the block storage alone when it was already moved to another server outside of this resource
+	if r == nil || ncloud.StringValue(r.ServerInstanceNo) != d.Get("server_instance_no").(string) {
+		return nil
+	}
+
+	return detachBlockStorageFromServer(d, config, d.Get("server_instance_no").(string))
+}
+
+// detachBlockStorageFromServer detaches the block storage and waits for the server to settle.
+// With `force_detach`, a failed detach is retried once while the server is stopped.
+// A server stopped for the detach is started again if it was running.
+func detachBlockStorageFromServer(d *schema.ResourceData, config *conn.ProviderConfig, serverInstanceNo string) error {
+	detach := func() error {
+		return detachBlockStorage(config, d.Id())
+	}
+
+	var err error
+	if d.Get("stop_instance_before_detaching").(bool) {
+		err = stopServerInstanceDuring(config, serverInstanceNo, "block storage", detach)
+	} else {
+		err = detach()
+		if err != nil && d.Get("force_detach").(bool) {
+			log.Printf("[WARN] Detaching Block Storage %s failed, stopping Instance %s and retrying: %s", d.Id(), serverInstanceNo, err)
+			err = stopServerInstanceDuring(config, serverInstanceNo, "block storage", detach)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	return detachThenWaitServerInstance(config, serverInstanceNo)
+}
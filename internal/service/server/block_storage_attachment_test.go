@@ -0,0 +1,159 @@
+package server_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/server"
+)
+
+func TestAccResourceNcloudBlockStorageAttachment_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-bs-attach-%s", acctest.RandString(5))
+	resourceName := "ncloud_block_storage_attachment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckBlockStorageAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageAttachmentConfig(name, "primary"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageAttachmentExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "block_storage_no", "ncloud_block_storage.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "server_instance_no", "ncloud_server.primary", "id"),
+					resource.TestMatchResourceAttr(resourceName, "device_name", regexp.MustCompile(`^/dev/`)),
+				),
+			},
+			{
+				// Failover moves the volume to the standby server without replacing it
+				Config: testAccBlockStorageAttachmentConfig(name, "standby"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageAttachmentExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "server_instance_no", "ncloud_server.standby", "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"stop_instance_before_detaching", "force_detach"},
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageAttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no Block Storage Attachment id is set")
+		}
+
+		config := TestAccProvider.Meta().(*conn.ProviderConfig)
+		r, err := server.GetBlockStorage(config, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if r == nil || ncloud.StringValue(r.ServerInstanceNo) != rs.Primary.Attributes["server_instance_no"] {
+			return fmt.Errorf("Block Storage %s is not attached to %s", rs.Primary.ID, rs.Primary.Attributes["server_instance_no"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBlockStorageAttachmentDestroy(s *terraform.State) error {
+	config := TestAccProvider.Meta().(*conn.ProviderConfig)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ncloud_block_storage_attachment" {
+			continue
+		}
+
+		r, err := server.GetBlockStorage(config, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if r != nil && ncloud.StringValue(r.ServerInstanceNo) == rs.Primary.Attributes["server_instance_no"] {
+			return fmt.Errorf("Block Storage %s is still attached to %s", rs.Primary.ID, rs.Primary.Attributes["server_instance_no"])
+		}
+	}
+
+	return nil
+}
+
+func testAccBlockStorageAttachmentConfig(name string, target string) string {
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "loginkey" {
+	key_name = "%[1]s-key"
+}
+
+resource "ncloud_vpc" "test" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.5.0.0/16"
+}
+
+resource "ncloud_subnet" "test" {
+	vpc_no             = ncloud_vpc.test.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.5.0.0/24"
+	zone               = "KR-2"
+	network_acl_no     = ncloud_vpc.test.default_network_acl_no
+	subnet_type        = "PUBLIC"
+	usage_type         = "GEN"
+}
+
+data "ncloud_server_image_numbers" "server_images" {
+	hypervisor_type = "KVM"
+	filter {
+		name = "name"
+		values = ["ubuntu-22.04-base"]
+	}
+}
+
+resource "ncloud_server" "primary" {
+	subnet_no = ncloud_subnet.test.id
+	name = "%[1]s-primary"
+	server_image_number = data.ncloud_server_image_numbers.server_images.image_number_list.0.server_image_number
+	server_spec_code = "s2-g3"
+	login_key_name = ncloud_login_key.loginkey.key_name
+}
+
+resource "ncloud_server" "standby" {
+	subnet_no = ncloud_subnet.test.id
+	name = "%[1]s-standby"
+	server_image_number = data.ncloud_server_image_numbers.server_images.image_number_list.0.server_image_number
+	server_spec_code = "s2-g3"
+	login_key_name = ncloud_login_key.loginkey.key_name
+}
+
+resource "ncloud_block_storage" "test" {
+	name = "%[1]s"
+	size = "10"
+	hypervisor_type = "KVM"
+	volume_type = "CB1"
+	zone = "KR-2"
+}
+
+resource "ncloud_block_storage_attachment" "test" {
+	block_storage_no = ncloud_block_storage.test.id
+	server_instance_no = ncloud_server.%[2]s.id
+	stop_instance_before_detaching = true
+}
+`, name, target)
+}
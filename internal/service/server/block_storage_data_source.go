@@ -93,6 +93,7 @@ func getBlockStorageList(d *schema.ResourceData, config *conn.ProviderConfig) ([
 			DiskDetailType:          common.GetCodePtrByCommonCode(r.BlockStorageDiskDetailType),
 			ZoneCode:                r.ZoneCode,
 			MaxIops:                 r.MaxIopsThroughput,
+			Throughput:              r.Throughput,
 			EncryptedVolume:         r.IsEncryptedVolume,
 			ReturnProtection:        r.IsReturnProtection,
 			VolumeType:              common.GetCodePtrByCommonCode(r.BlockStorageVolumeType),
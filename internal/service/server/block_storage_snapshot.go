@@ -313,4 +313,5 @@ type BlockStorageSnapshot struct {
 	// for DataSource
 	SnapshotNo     *string `json:"snapshot_no,omitempty"`
 	BlockStorageNo *string `json:"block_storage_no,omitempty"`
+	CreateDate     *string `json:"create_date,omitempty"`
 }
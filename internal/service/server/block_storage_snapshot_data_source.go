@@ -43,6 +43,10 @@ func DataSourceNcloudBlockStorageSnapshot() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"create_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"filter": DataSourceFiltersSchema(),
 		},
 	}
@@ -87,6 +91,10 @@ func GetBlockStorageSnapshot(d *schema.ResourceData, config *conn.ProviderConfig
 		reqParams.BlockStorageSnapshotInstanceNoList = []*string{ncloud.String(v.(string))}
 	}
 
+	return getBlockStorageSnapshotList(config, reqParams)
+}
+
+func getBlockStorageSnapshotList(config *conn.ProviderConfig, reqParams *vserver.GetBlockStorageSnapshotInstanceListRequest) ([]*BlockStorageSnapshot, error) {
 	LogCommonRequest("getVpcBlockStorageSnapshot", reqParams)
 	resp, err := config.Client.Vserver.V2Api.GetBlockStorageSnapshotInstanceList(reqParams)
 	if err != nil {
@@ -114,5 +122,6 @@ func convertVpcSnapshotInstance(r *vserver.BlockStorageSnapshotInstance) *BlockS
 		BlockStorageSnapshotVolumeSize: r.BlockStorageSnapshotVolumeSize,
 		BlockStorageNo:                 r.OriginalBlockStorageInstanceNo,
 		Description:                    r.BlockStorageSnapshotDescription,
+		CreateDate:                     r.CreateDate,
 	}
 }
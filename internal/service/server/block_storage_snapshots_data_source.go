@@ -0,0 +1,86 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+func DataSourceNcloudBlockStorageSnapshots() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNcloudBlockStorageSnapshotsRead,
+
+		Schema: map[string]*schema.Schema{
+			"block_storage_no": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Original block storage instance number of the snapshots you want to get",
+			},
+			"filter": DataSourceFiltersSchema(),
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"snapshots": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     blockStorageSnapshotsDataSourceItemSchema(),
+			},
+		},
+	}
+}
+
+func blockStorageSnapshotsDataSourceItemSchema() *schema.Resource {
+	r := DataSourceNcloudBlockStorageSnapshot()
+	delete(r.Schema, "filter")
+
+	return GetDataSourceItemSchema(r)
+}
+
+func dataSourceNcloudBlockStorageSnapshotsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+
+	reqParams := &vserver.GetBlockStorageSnapshotInstanceListRequest{
+		RegionCode: &config.RegionCode,
+	}
+
+	if v, ok := d.GetOk("block_storage_no"); ok {
+		reqParams.OriginalBlockStorageInstanceNoList = []*string{ncloud.String(v.(string))}
+	}
+
+	instances, err := getBlockStorageSnapshotList(config, reqParams)
+	if err != nil {
+		return err
+	}
+
+	resources := ConvertToArrayMap(instances)
+	for _, r := range resources {
+		r["id"] = r["snapshot_no"]
+	}
+
+	if f, ok := d.GetOk("filter"); ok {
+		resources = ApplyFilters(f.(*schema.Set), resources, DataSourceNcloudBlockStorageSnapshots().Schema["snapshots"].Elem.(*schema.Resource).Schema)
+	}
+
+	var ids []string
+	for _, r := range resources {
+		ids = append(ids, r["id"].(string))
+	}
+
+	d.SetId(DataResourceIdHash(ids))
+	if err := d.Set("snapshots", resources); err != nil {
+		return fmt.Errorf("error setting Block Storage Snapshots: %s", err)
+	}
+
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		return WriteToFile(output.(string), resources)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package server_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+)
+
+func TestAccDataSourceNcloudBlockStorageSnapshots_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-snaps-%s", acctest.RandString(5))
+	dataName := "data.ncloud_block_storage_snapshots.by_filter"
+	resourceName := "ncloud_block_storage_snapshot.snapshot"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckVpcBlockStorageSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNcloudBlockStorageSnapshotsConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					TestAccCheckDataSourceID(dataName),
+					resource.TestCheckResourceAttr(dataName, "snapshots.#", "1"),
+					resource.TestCheckResourceAttrPair(dataName, "snapshots.0.snapshot_no", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataName, "snapshots.0.block_storage_no", "ncloud_block_storage.storage", "id"),
+					resource.TestCheckResourceAttr(dataName, "snapshots.0.name", name+"-tf"),
+					resource.TestMatchResourceAttr(dataName, "snapshots.0.create_date", regexp.MustCompile(`^\d{4}-\d{2}-\d{2}`)),
+					resource.TestCheckNoResourceAttr(dataName, "snapshots.0.filter.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceNcloudBlockStorageSnapshotsConfig(name string) string {
+	return testAccBlockStorageSnapshotVpcConfig(name, "KVM", "KR-2", "s2-g3", "CB1") + `
+data "ncloud_block_storage_snapshots" "by_filter" {
+	block_storage_no = ncloud_block_storage_snapshot.snapshot.block_storage_instance_no
+
+	filter {
+		name   = "snapshot_no"
+		values = [ncloud_block_storage_snapshot.snapshot.id]
+	}
+}
+`
+}
@@ -90,6 +90,42 @@ func TestAccResourceNcloudBlockStorage_vpc_kvm(t *testing.T) {
 	})
 }
 
+func TestAccResourceNcloudBlockStorage_vpc_kvmIops(t *testing.T) {
+	var before, after server.BlockStorage
+	name := fmt.Sprintf("tf-storage-iops-%s", acctest.RandString(5))
+	resourceName := "ncloud_block_storage.storage"
+	zone := "KR-2"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckBlockStorageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageVpcConfigKvmIops(name, zone, 3000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageExistsWithProvider(resourceName, &before, TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "volume_type", "FB1"),
+					resource.TestCheckResourceAttr(resourceName, "max_iops", "3000"),
+				),
+			},
+			{
+				Config: testAccBlockStorageVpcConfigKvmIops(name, zone, 5000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageExistsWithProvider(resourceName, &after, TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "max_iops", "5000"),
+					func(*terraform.State) error {
+						if *before.BlockStorageInstanceNo != *after.BlockStorageInstanceNo {
+							return fmt.Errorf("block storage was replaced: %s -> %s", *before.BlockStorageInstanceNo, *after.BlockStorageInstanceNo)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceNcloudBlockStorage_vpc_ChangeServerInstance(t *testing.T) {
 	var storageInstance server.BlockStorage
 	name := fmt.Sprintf("tf-storage-update-%s", acctest.RandString(5))
@@ -361,3 +397,53 @@ resource "ncloud_block_storage" "storage" {
 }
 `, name, zone, volumeType)
 }
+
+func testAccBlockStorageVpcConfigKvmIops(name string, zone string, iops int) string {
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "loginkey" {
+	key_name = "%[1]s-key"
+}
+
+resource "ncloud_vpc" "test" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.5.0.0/16"
+}
+
+resource "ncloud_subnet" "test" {
+	vpc_no             = ncloud_vpc.test.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.5.0.0/24"
+	zone               = "%[2]s"
+	network_acl_no     = ncloud_vpc.test.default_network_acl_no
+	subnet_type        = "PUBLIC"
+	usage_type         = "GEN"
+}
+
+data "ncloud_server_image_numbers" "server_images" {
+	hypervisor_type = "KVM"
+	filter {
+		name = "name"
+		values = ["ubuntu-22.04-base"]
+	}
+}
+
+resource "ncloud_server" "server" {
+	subnet_no = ncloud_subnet.test.id
+	name = "%[1]s"
+	server_image_number = data.ncloud_server_image_numbers.server_images.image_number_list.0.server_image_number
+	server_spec_code = "s2-g3"
+	login_key_name = ncloud_login_key.loginkey.key_name
+}
+
+resource "ncloud_block_storage" "storage" {
+	server_instance_no = ncloud_server.server.id
+	name = "%[1]s-tf"
+	size = "100"
+	hypervisor_type = "KVM"
+	volume_type = "FB1"
+	zone = "%[2]s"
+	max_iops = %[3]d
+	stop_instance_before_detaching = true
+}
+`, name, zone, iops)
+}
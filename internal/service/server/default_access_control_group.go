@@ -0,0 +1,234 @@
+package server
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/vpc"
+)
+
+// ResourceNcloudDefaultAccessControlGroup adopts the ACG created with a VPC.
+// The ACG is never created or deleted: the rules found when the resource takes ownership
+// are kept in `default_inbound`/`default_outbound` and put back on destroy.
+func ResourceNcloudDefaultAccessControlGroup() *schema.Resource {
+	ruleSchema := ResourceNcloudAccessControlGroupRule().Schema
+
+	return &schema.Resource{
+		Create: resourceNcloudDefaultAccessControlGroupCreate,
+		Read:   resourceNcloudDefaultAccessControlGroupRead,
+		Update: resourceNcloudDefaultAccessControlGroupUpdate,
+		Delete: resourceNcloudDefaultAccessControlGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNcloudDefaultAccessControlGroupImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"vpc_no": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"inbound":  ruleSchema["inbound"],
+			"outbound": ruleSchema["outbound"],
+			"access_control_group_no": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The API doesn't allow changing the description of an ACG.",
+			},
+			"default_inbound": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        ruleSchema["inbound"].Elem,
+				Description: "Inbound rules found when the resource took ownership of the ACG, restored on destroy.",
+			},
+			"default_outbound": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        ruleSchema["outbound"].Elem,
+				Description: "Outbound rules found when the resource took ownership of the ACG, restored on destroy.",
+			},
+		},
+	}
+}
+
+func resourceNcloudDefaultAccessControlGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+
+	accessControlGroupNo, err := vpc.GetDefaultAccessControlGroup(config, d.Get("vpc_no").(string))
+	if err != nil {
+		return err
+	}
+
+	accessControlGroup, err := snapshotDefaultAccessControlGroup(d, config, accessControlGroupNo)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(accessControlGroupNo)
+	log.Printf("[INFO] Adopted default ACG ID: %s", d.Id())
+
+	for _, ruleType := range []string{"inbound", "outbound"} {
+		current := d.Get("default_" + ruleType).(*schema.Set)
+		desired := d.Get(ruleType).(*schema.Set)
+		if err := reconcileAccessControlGroupRules(d, config, accessControlGroup, ruleType, current, desired); err != nil {
+			return err
+		}
+	}
+
+	return resourceNcloudDefaultAccessControlGroupRead(d, meta)
+}
+
+func resourceNcloudDefaultAccessControlGroupRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+
+	accessControlGroup, err := GetAccessControlGroup(config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if accessControlGroup == nil {
+		log.Printf("[WARN] Default ACG (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	rules, err := GetAccessControlGroupRuleList(config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Set("vpc_no", accessControlGroup.VpcNo)
+	d.Set("access_control_group_no", accessControlGroup.AccessControlGroupNo)
+	d.Set("name", accessControlGroup.AccessControlGroupName)
+	d.Set("description", accessControlGroup.AccessControlGroupDescription)
+
+	iSet, oSet := flattenAccessControlGroupRules(rules)
+
+	if err := d.Set("inbound", iSet.List()); err != nil {
+		log.Printf("[WARN] Error setting inbound rule set for (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("outbound", oSet.List()); err != nil {
+		log.Printf("[WARN] Error setting outbound rule set for (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceNcloudDefaultAccessControlGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+
+	for _, ruleType := range []string{"inbound", "outbound"} {
+		if d.HasChange(ruleType) {
+			if err := updateAccessControlGroupRule(d, config, ruleType); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceNcloudDefaultAccessControlGroupRead(d, meta)
+}
+
+// resourceNcloudDefaultAccessControlGroupDelete puts back the rules captured when the resource took ownership.
+// The ACG itself is deleted along with its VPC.
+func resourceNcloudDefaultAccessControlGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+
+	accessControlGroup, err := GetAccessControlGroup(config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if accessControlGroup == nil {
+		return nil
+	}
+
+	rules, err := GetAccessControlGroupRuleList(config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	iSet, oSet := flattenAccessControlGroupRules(rules)
+
+	if err := reconcileAccessControlGroupRules(d, config, accessControlGroup, "inbound", iSet, d.Get("default_inbound").(*schema.Set)); err != nil {
+		return err
+	}
+
+	return reconcileAccessControlGroupRules(d, config, accessControlGroup, "outbound", oSet, d.Get("default_outbound").(*schema.Set))
+}
+
+func resourceNcloudDefaultAccessControlGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*conn.ProviderConfig)
+
+	if _, err := snapshotDefaultAccessControlGroup(d, config, d.Id()); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// snapshotDefaultAccessControlGroup stores the current rules of the default ACG in `default_inbound`/`default_outbound`.
+func snapshotDefaultAccessControlGroup(d *schema.ResourceData, config *conn.ProviderConfig, accessControlGroupNo string) (*vserver.AccessControlGroup, error) {
+	accessControlGroup, err := GetAccessControlGroup(config, accessControlGroupNo)
+	if err != nil {
+		return nil, err
+	}
+
+	if accessControlGroup == nil {
+		return nil, fmt.Errorf("no matching Access Control Group: %s", accessControlGroupNo)
+	}
+
+	if !ncloud.BoolValue(accessControlGroup.IsDefault) {
+		return nil, fmt.Errorf("access control group (%s) is not the default ACG of VPC (%s)", accessControlGroupNo, ncloud.StringValue(accessControlGroup.VpcNo))
+	}
+
+	rules, err := GetAccessControlGroupRuleList(config, accessControlGroupNo)
+	if err != nil {
+		return nil, err
+	}
+
+	iSet, oSet := flattenAccessControlGroupRules(rules)
+
+	d.Set("vpc_no", accessControlGroup.VpcNo)
+	if err := d.Set("default_inbound", iSet.List()); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("default_outbound", oSet.List()); err != nil {
+		return nil, err
+	}
+
+	return accessControlGroup, nil
+}
+
+// reconcileAccessControlGroupRules removes the rules of current missing from desired, then adds the rules of desired missing from current.
+func reconcileAccessControlGroupRules(d *schema.ResourceData, config *conn.ProviderConfig, accessControlGroup *vserver.AccessControlGroup, ruleType string, current, desired *schema.Set) error {
+	if remove := expandRemoveAccessControlGroupRule(current.Difference(desired).List()); len(remove) > 0 {
+		if err := removeAccessControlGroupRule(d, config, ruleType, accessControlGroup, remove); err != nil {
+			return err
+		}
+	}
+
+	add, err := expandAddAccessControlGroupRule(desired.Difference(current).List())
+	if err != nil {
+		return err
+	}
+
+	if len(add) > 0 {
+		return addAccessControlGroupRule(d, config, ruleType, accessControlGroup, add)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package server_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+)
+
+func TestAccResourceNcloudDefaultAccessControlGroup_basic(t *testing.T) {
+	var accessControlGroup vserver.AccessControlGroup
+
+	resourceName := "ncloud_default_access_control_group.test"
+	name := fmt.Sprintf("test-default-acg-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudDefaultAccessControlGroupConfig(name, "22"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessControlGroupExists(resourceName, &accessControlGroup),
+					resource.TestCheckResourceAttrPair(resourceName, "access_control_group_no", "ncloud_vpc.test", "default_access_control_group_no"),
+					resource.TestCheckResourceAttr(resourceName, "inbound.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "inbound.0.port_range", "22"),
+					resource.TestCheckResourceAttr(resourceName, "outbound.#", "1"),
+				),
+			},
+			{
+				Config: testAccResourceNcloudDefaultAccessControlGroupConfig(name, "8080"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "inbound.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "inbound.0.port_range", "8080"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"default_inbound", "default_outbound"},
+			},
+		},
+	})
+}
+
+func testAccResourceNcloudDefaultAccessControlGroupConfig(name, port string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "test" {
+	name            = "%[1]s"
+	ipv4_cidr_block = "10.4.0.0/16"
+}
+
+resource "ncloud_default_access_control_group" "test" {
+	vpc_no = ncloud_vpc.test.vpc_no
+
+	inbound {
+		protocol    = "TCP"
+		ip_block    = "10.0.0.0/8"
+		port_range  = "%[2]s"
+		description = "managed by terraform"
+	}
+
+	outbound {
+		protocol    = "TCP"
+		ip_block    = "0.0.0.0/0"
+		port_range  = "1-65535"
+	}
+}
+`, name, port)
+}
@@ -0,0 +1,290 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+)
+
+var (
+	_ resource.Resource                = &flowLogResource{}
+	_ resource.ResourceWithConfigure   = &flowLogResource{}
+	_ resource.ResourceWithImportState = &flowLogResource{}
+)
+
+func NewFlowLogResource() resource.Resource {
+	return &flowLogResource{}
+}
+
+// flowLogResource enables flow log collection on a network interface.
+// The API keeps one flow log configuration per network interface, so the network interface number is the ID.
+type flowLogResource struct {
+	config *conn.ProviderConfig
+}
+
+func (f *flowLogResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_flow_log"
+}
+
+func (f *flowLogResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"network_interface_no": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"collect_action_type": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"ALLOW", "DENY", "ALL"}...),
+				},
+				Description: "Traffic to collect: accepted (`ALLOW`), rejected (`DENY`) or both (`ALL`).",
+			},
+			"collect_interval_minute": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				Description: "Aggregation interval of the flow log records, in minutes.",
+			},
+			"storage_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Storage the flow logs are delivered to. `OBJT` (Object Storage) when omitted.",
+			},
+			"storage_bucket_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"storage_bucket_directory_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (f *flowLogResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	f.config = config
+}
+
+func (f *flowLogResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_interface_no"), req.ID)...)
+}
+
+func (f *flowLogResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan flowLogResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqParams := &vserver.EnableFlowLogRequest{
+		RegionCode:            &f.config.RegionCode,
+		NetworkInterfaceNo:    plan.NetworkInterfaceNo.ValueStringPointer(),
+		CollectActionTypeCode: plan.CollectActionType.ValueStringPointer(),
+		StorageBucketName:     plan.StorageBucketName.ValueStringPointer(),
+	}
+	if !plan.CollectIntervalMinute.IsNull() && !plan.CollectIntervalMinute.IsUnknown() {
+		reqParams.CollectIntervalMinute = ncloud.Int32(int32(plan.CollectIntervalMinute.ValueInt64()))
+	}
+	if !plan.StorageType.IsNull() && !plan.StorageType.IsUnknown() {
+		reqParams.StorageTypeCode = plan.StorageType.ValueStringPointer()
+	}
+	if !plan.StorageBucketDirectoryName.IsNull() && !plan.StorageBucketDirectoryName.IsUnknown() {
+		reqParams.StorageBucketDirectoryName = plan.StorageBucketDirectoryName.ValueStringPointer()
+	}
+
+	tflog.Info(ctx, "EnableFlowLog", map[string]any{
+		"reqParams": common.MarshalUncheckedString(reqParams),
+	})
+	response, err := f.config.Client.Vserver.V2Api.EnableFlowLog(reqParams)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating FlowLog", err.Error())
+		return
+	}
+	tflog.Info(ctx, "EnableFlowLog response", map[string]any{
+		"enableFlowLogResponse": common.MarshalUncheckedString(response),
+	})
+
+	output, err := GetFlowLogConfiguration(ctx, f.config, plan.NetworkInterfaceNo.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("GetFlowLogConfiguration", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.Diagnostics.AddError("Error Creating FlowLog", fmt.Sprintf("no flow log configuration found for network interface %s after enabling it", plan.NetworkInterfaceNo.ValueString()))
+		return
+	}
+
+	plan.refreshFromOutput(output)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (f *flowLogResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state flowLogResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := GetFlowLogConfiguration(ctx, f.config, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("GetFlowLogConfiguration", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.refreshFromOutput(output)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (f *flowLogResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+}
+
+func (f *flowLogResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state flowLogResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqParams := &vserver.DisableFlowLogRequest{
+		RegionCode:         &f.config.RegionCode,
+		NetworkInterfaceNo: state.ID.ValueStringPointer(),
+	}
+
+	tflog.Info(ctx, "DisableFlowLog", map[string]any{
+		"reqParams": common.MarshalUncheckedString(reqParams),
+	})
+	response, err := f.config.Client.Vserver.V2Api.DisableFlowLog(reqParams)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting FlowLog", err.Error())
+		return
+	}
+	tflog.Info(ctx, "DisableFlowLog response", map[string]any{
+		"disableFlowLogResponse": common.MarshalUncheckedString(response),
+	})
+}
+
+func GetFlowLogConfiguration(ctx context.Context, config *conn.ProviderConfig, networkInterfaceNo string) (*vserver.FlowLogConfiguration, error) {
+	list, err := getFlowLogConfigurationList(ctx, config, []string{networkInterfaceNo})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range list {
+		if ncloud.StringValue(c.NetworkInterfaceNo) == networkInterfaceNo {
+			return c, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func getFlowLogConfigurationList(ctx context.Context, config *conn.ProviderConfig, networkInterfaceNoList []string) ([]*vserver.FlowLogConfiguration, error) {
+	reqParams := &vserver.GetFlowLogConfigurationListRequest{
+		RegionCode:             &config.RegionCode,
+		NetworkInterfaceNoList: ncloud.StringList(networkInterfaceNoList),
+	}
+
+	tflog.Info(ctx, "GetFlowLogConfigurationList", map[string]any{
+		"reqParams": common.MarshalUncheckedString(reqParams),
+	})
+	resp, err := config.Client.Vserver.V2Api.GetFlowLogConfigurationList(reqParams)
+	if err != nil {
+		tflog.Error(ctx, "GetFlowLogConfigurationList", map[string]any{
+			"reqParams": common.MarshalUncheckedString(reqParams),
+			"error":     err,
+		})
+		return nil, err
+	}
+	tflog.Info(ctx, "GetFlowLogConfigurationList response", map[string]any{
+		"resp": common.MarshalUncheckedString(resp),
+	})
+
+	return resp.FlowLogConfigurationList, nil
+}
+
+type flowLogResourceModel struct {
+	ID                         types.String `tfsdk:"id"`
+	NetworkInterfaceNo         types.String `tfsdk:"network_interface_no"`
+	CollectActionType          types.String `tfsdk:"collect_action_type"`
+	CollectIntervalMinute      types.Int64  `tfsdk:"collect_interval_minute"`
+	StorageType                types.String `tfsdk:"storage_type"`
+	StorageBucketName          types.String `tfsdk:"storage_bucket_name"`
+	StorageBucketDirectoryName types.String `tfsdk:"storage_bucket_directory_name"`
+}
+
+func (m *flowLogResourceModel) refreshFromOutput(output *vserver.FlowLogConfiguration) {
+	m.ID = types.StringPointerValue(output.NetworkInterfaceNo)
+	m.NetworkInterfaceNo = types.StringPointerValue(output.NetworkInterfaceNo)
+	m.CollectActionType = types.StringPointerValue(common.GetCodePtrByCommonCode(output.CollectActionType))
+	m.CollectIntervalMinute = types.Int64Value(int64(ncloud.Int32Value(output.CollectIntervalMinute)))
+	m.StorageType = types.StringPointerValue(common.GetCodePtrByCommonCode(output.StorageType))
+	m.StorageBucketName = types.StringPointerValue(output.StorageBucketName)
+	m.StorageBucketDirectoryName = types.StringValue(ncloud.StringValue(output.StorageBucketDirectoryName))
+}
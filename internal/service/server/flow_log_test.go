@@ -0,0 +1,135 @@
+package server_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/server"
+)
+
+func TestAccResourceNcloudFlowLog_basic(t *testing.T) {
+	resourceName := "ncloud_flow_log.test"
+	dataName := "data.ncloud_flow_logs.test"
+	name := fmt.Sprintf("tf-flow-log-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckFlowLogDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudFlowLogConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowLogExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "network_interface_no", "ncloud_network_interface.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "collect_action_type", "ALL"),
+					resource.TestCheckResourceAttr(resourceName, "storage_type", "OBJT"),
+					resource.TestCheckResourceAttr(resourceName, "storage_bucket_name", name),
+					resource.TestCheckResourceAttr(resourceName, "storage_bucket_directory_name", "flow-log"),
+					resource.TestCheckResourceAttr(dataName, "flow_logs.#", "1"),
+					resource.TestCheckResourceAttrPair(dataName, "flow_logs.0.network_interface_no", resourceName, "network_interface_no"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceNcloudFlowLogConfig(name string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "test" {
+	name            = "%[1]s"
+	ipv4_cidr_block = "10.4.0.0/16"
+}
+
+resource "ncloud_subnet" "test" {
+	vpc_no         = ncloud_vpc.test.vpc_no
+	name           = "%[1]s"
+	subnet         = "10.4.0.0/24"
+	zone           = "KR-1"
+	network_acl_no = ncloud_vpc.test.default_network_acl_no
+	subnet_type    = "PUBLIC"
+	usage_type     = "GEN"
+}
+
+resource "ncloud_network_interface" "test" {
+	name                  = "%[1]s"
+	subnet_no             = ncloud_subnet.test.id
+	access_control_groups = [ncloud_vpc.test.default_access_control_group_no]
+}
+
+resource "ncloud_objectstorage_bucket" "test" {
+	bucket_name = "%[1]s"
+}
+
+resource "ncloud_flow_log" "test" {
+	network_interface_no          = ncloud_network_interface.test.id
+	collect_action_type           = "ALL"
+	storage_bucket_name           = ncloud_objectstorage_bucket.test.bucket_name
+	storage_bucket_directory_name = "flow-log"
+}
+
+data "ncloud_flow_logs" "test" {
+	network_interface_no_list = [ncloud_flow_log.test.network_interface_no]
+}
+`, name)
+}
+
+func testAccCheckFlowLogExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no flow log id is set")
+		}
+
+		config := TestAccProvider.Meta().(*conn.ProviderConfig)
+		flowLog, err := server.GetFlowLogConfiguration(context.Background(), config, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if flowLog == nil {
+			return fmt.Errorf("flow log not found for network interface %s", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFlowLogDestroy(s *terraform.State) error {
+	config := TestAccProvider.Meta().(*conn.ProviderConfig)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ncloud_flow_log" {
+			continue
+		}
+
+		flowLog, err := server.GetFlowLogConfiguration(context.Background(), config, rs.Primary.ID)
+		if err != nil {
+			// The network interface is gone along with its flow log
+			continue
+		}
+
+		if flowLog != nil {
+			return errors.New("flow log still exists")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+var (
+	_ datasource.DataSource              = &flowLogsDataSource{}
+	_ datasource.DataSourceWithConfigure = &flowLogsDataSource{}
+)
+
+func NewFlowLogsDataSource() datasource.DataSource {
+	return &flowLogsDataSource{}
+}
+
+type flowLogsDataSource struct {
+	config *conn.ProviderConfig
+}
+
+func (d *flowLogsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_flow_logs"
+}
+
+func (d *flowLogsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"network_interface_no_list": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				Description: "Network interfaces to look up. Network interfaces without a flow log are left out of `flow_logs`.",
+			},
+			"flow_logs": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"network_interface_no": schema.StringAttribute{
+							Computed: true,
+						},
+						"collect_action_type": schema.StringAttribute{
+							Computed: true,
+						},
+						"collect_interval_minute": schema.Int64Attribute{
+							Computed: true,
+						},
+						"storage_type": schema.StringAttribute{
+							Computed: true,
+						},
+						"storage_bucket_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"storage_bucket_directory_name": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": common.DataSourceFiltersBlock(),
+		},
+	}
+}
+
+func (d *flowLogsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.config = config
+}
+
+func (d *flowLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data flowLogsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var networkInterfaceNoList []string
+	resp.Diagnostics.Append(data.NetworkInterfaceNoList.ElementsAs(ctx, &networkInterfaceNoList, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := getFlowLogConfigurationList(ctx, d.config, networkInterfaceNoList)
+	if err != nil {
+		resp.Diagnostics.AddError("GetFlowLogConfigurationList", err.Error())
+		return
+	}
+
+	var flowLogs []*flowLogResourceModel
+	for _, c := range list {
+		var m flowLogResourceModel
+		m.refreshFromOutput(c)
+		flowLogs = append(flowLogs, &m)
+	}
+
+	data.FlowLogs = []flowLogResourceModel{}
+	for _, m := range common.FilterModels(ctx, data.Filters, flowLogs) {
+		data.FlowLogs = append(data.FlowLogs, *m)
+	}
+	data.ID = types.StringValue(strings.Join(networkInterfaceNoList, ","))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+type flowLogsDataSourceModel struct {
+	ID                     types.String           `tfsdk:"id"`
+	NetworkInterfaceNoList types.List             `tfsdk:"network_interface_no_list"`
+	FlowLogs               []flowLogResourceModel `tfsdk:"flow_logs"`
+	Filters                types.Set              `tfsdk:"filter"`
+}
@@ -34,3 +34,61 @@ func flattenMapByKey(i interface{}, key string) *string {
 		return nil
 	}
 }
+
+// serverNetworkInterfaceOrder is network interface number by order
+type serverNetworkInterfaceOrder map[int]string
+
+func expandServerNetworkInterfaceOrders(l []interface{}) serverNetworkInterfaceOrder {
+	orders := serverNetworkInterfaceOrder{}
+	for _, v := range l {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		orders[m["order"].(int)] = m["network_interface_no"].(string)
+	}
+
+	return orders
+}
+
+func primaryNetworkInterfaceNo(l []interface{}) string {
+	return expandServerNetworkInterfaceOrders(l)[0]
+}
+
+// diffServerNetworkInterfaces returns secondary network interfaces to detach and to attach.
+// Every interface from the first changed order onwards is re-attached to keep the device names in order.
+func diffServerNetworkInterfaces(o, n serverNetworkInterfaceOrder) ([]string, []string) {
+	maxOrder := 0
+	for order := range o {
+		maxOrder = max(maxOrder, order)
+	}
+	for order := range n {
+		maxOrder = max(maxOrder, order)
+	}
+
+	firstChanged := -1
+	for order := 1; order <= maxOrder; order++ {
+		if o[order] != n[order] {
+			firstChanged = order
+			break
+		}
+	}
+
+	if firstChanged < 0 {
+		return nil, nil
+	}
+
+	var detachList, attachList []string
+	for order := firstChanged; order <= maxOrder; order++ {
+		if no, ok := o[order]; ok && len(no) > 0 {
+			detachList = append(detachList, no)
+		}
+	}
+	for order := firstChanged; order <= maxOrder; order++ {
+		if no, ok := n[order]; ok && len(no) > 0 {
+			attachList = append(attachList, no)
+		}
+	}
+
+	return detachList, attachList
+}
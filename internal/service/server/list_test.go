@@ -1,6 +1,7 @@
 package server
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -94,3 +95,63 @@ func TestExpandBlockDevicePartitionListParams_InvalidInput(t *testing.T) {
 		t.Fatalf("expected PartitionSize to be nil, but got %s", *partition.PartitionSize)
 	}
 }
+
+func TestDiffServerNetworkInterfaces(t *testing.T) {
+	cases := []struct {
+		name       string
+		old        serverNetworkInterfaceOrder
+		new        serverNetworkInterfaceOrder
+		wantDetach []string
+		wantAttach []string
+	}{
+		{
+			name: "unchanged",
+			old:  serverNetworkInterfaceOrder{0: "10", 1: "11"},
+			new:  serverNetworkInterfaceOrder{0: "10", 1: "11"},
+		},
+		{
+			name:       "add",
+			old:        serverNetworkInterfaceOrder{0: "10"},
+			new:        serverNetworkInterfaceOrder{0: "10", 1: "11"},
+			wantAttach: []string{"11"},
+		},
+		{
+			name:       "remove",
+			old:        serverNetworkInterfaceOrder{0: "10", 1: "11", 2: "12"},
+			new:        serverNetworkInterfaceOrder{0: "10", 1: "11"},
+			wantDetach: []string{"12"},
+		},
+		{
+			name:       "reorder",
+			old:        serverNetworkInterfaceOrder{0: "10", 1: "11", 2: "12"},
+			new:        serverNetworkInterfaceOrder{0: "10", 1: "12", 2: "11"},
+			wantDetach: []string{"11", "12"},
+			wantAttach: []string{"12", "11"},
+		},
+	}
+
+	for _, tc := range cases {
+		detach, attach := diffServerNetworkInterfaces(tc.old, tc.new)
+		if !reflect.DeepEqual(detach, tc.wantDetach) {
+			t.Fatalf("%s: expected detach %v, but got %v", tc.name, tc.wantDetach, detach)
+		}
+		if !reflect.DeepEqual(attach, tc.wantAttach) {
+			t.Fatalf("%s: expected attach %v, but got %v", tc.name, tc.wantAttach, attach)
+		}
+	}
+}
+
+func TestPrimaryNetworkInterfaceNo(t *testing.T) {
+	l := []interface{}{
+		map[string]interface{}{"order": 1, "network_interface_no": "11"},
+		map[string]interface{}{"order": 0, "network_interface_no": "10"},
+	}
+
+	if no := primaryNetworkInterfaceNo(l); no != "10" {
+		t.Fatalf("expected primary network interface 10, but got %s", no)
+	}
+
+	if no := primaryNetworkInterfaceNo(nil); no != "" {
+		t.Fatalf("expected empty primary network interface, but got %s", no)
+	}
+}
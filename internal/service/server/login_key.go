@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	sdkresource "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"golang.org/x/crypto/ssh"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -23,6 +24,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
 	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify/verifystring"
 )
 
 var (
@@ -32,10 +34,12 @@ var (
 )
 
 type loginKeyResourceModel struct {
-	KeyName     types.String `tfsdk:"key_name"`
-	PrivateKey  types.String `tfsdk:"private_key"`
-	Fingerprint types.String `tfsdk:"fingerprint"`
-	ID          types.String `tfsdk:"id"`
+	KeyName              types.String `tfsdk:"key_name"`
+	PublicKey            types.String `tfsdk:"public_key"`
+	PrivateKey           types.String `tfsdk:"private_key"`
+	Fingerprint          types.String `tfsdk:"fingerprint"`
+	PublicKeyFingerprint types.String `tfsdk:"public_key_fingerprint"`
+	ID                   types.String `tfsdk:"id"`
 }
 
 type loginKeyResource struct {
@@ -67,13 +71,30 @@ func (l *loginKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 				Description: "Key name to generate. If the generated key name exists, an error occurs.",
 			},
+			"public_key": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					verifystring.SSHPublicKey(),
+				},
+				Description: "Existing public key in OpenSSH authorized_keys format to register. If omitted, Ncloud generates a new key pair.",
+			},
 			"private_key": schema.StringAttribute{
 				Computed:  true,
 				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					loginKeyPrivateKeyPlanModifier{},
+				},
 			},
 			"fingerprint": schema.StringAttribute{
 				Computed: true,
 			},
+			"public_key_fingerprint": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA256 fingerprint of `public_key`, in the same format as `ssh-keygen -l`.",
+			},
 			"id": framework.IDAttribute(),
 		},
 	}
@@ -110,7 +131,11 @@ func (l *loginKeyResource) Create(ctx context.Context, req resource.CreateReques
 
 	keyName := plan.KeyName.ValueStringPointer()
 
-	privatekey, err = createVpcLoginKey(ctx, l.config, keyName)
+	if !plan.PublicKey.IsNull() {
+		err = importVpcLoginKey(ctx, l.config, keyName, plan.PublicKey.ValueString())
+	} else {
+		privatekey, err = createVpcLoginKey(ctx, l.config, keyName)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating LoginKey",
@@ -126,7 +151,10 @@ func (l *loginKeyResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	plan.refreshFromOutput(output)
-	plan.PrivateKey = types.StringValue(strings.TrimSpace(*privatekey))
+	plan.PrivateKey = types.StringNull()
+	if privatekey != nil {
+		plan.PrivateKey = types.StringValue(strings.TrimSpace(*privatekey))
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
@@ -202,6 +230,28 @@ func createVpcLoginKey(ctx context.Context, config *conn.ProviderConfig, keyName
 	return resp.PrivateKey, err
 }
 
+// importVpcLoginKey registers an existing public key. Ncloud never sees the private key, so none is returned.
+func importVpcLoginKey(ctx context.Context, config *conn.ProviderConfig, keyName *string, publicKey string) error {
+	reqParams := &vserver.ImportLoginKeyRequest{
+		KeyName:   keyName,
+		PublicKey: ncloud.String(strings.TrimSpace(publicKey)),
+	}
+	tflog.Info(ctx, "ImportVpcLoginKey", map[string]any{
+		"reqParams": common.MarshalUncheckedString(reqParams),
+	})
+
+	resp, err := config.Client.Vserver.V2Api.ImportLoginKey(reqParams)
+	if err != nil {
+		common.LogErrorResponse("importVpcLoginKey", err, reqParams)
+		return err
+	}
+	tflog.Info(ctx, "ImportVpcLoginKey response", map[string]any{
+		"importVpcLoginKeyResponse": common.MarshalUncheckedString(resp),
+	})
+
+	return nil
+}
+
 func waitForNcloudLoginKeyCreation(config *conn.ProviderConfig, keyName string) (*LoginKey, error) {
 	var loginkey *LoginKey
 
@@ -305,4 +355,47 @@ func (l *loginKeyResourceModel) refreshFromOutput(output *LoginKey) {
 	l.ID = types.StringPointerValue(output.KeyName)
 	l.KeyName = types.StringPointerValue(output.KeyName)
 	l.Fingerprint = types.StringPointerValue(output.Fingerprint)
+	l.PublicKeyFingerprint = types.StringNull()
+	if fingerprint, err := sshPublicKeyFingerprint(l.PublicKey.ValueString()); err == nil {
+		l.PublicKeyFingerprint = types.StringValue(fingerprint)
+	}
+}
+
+// sshPublicKeyFingerprint returns the SHA256 fingerprint of an OpenSSH public key as printed by `ssh-keygen -l`
+func sshPublicKeyFingerprint(publicKey string) (string, error) {
+	if len(publicKey) == 0 {
+		return "", fmt.Errorf("public key is empty")
+	}
+
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+	if err != nil {
+		return "", err
+	}
+
+	return ssh.FingerprintSHA256(key), nil
+}
+
+// loginKeyPrivateKeyPlanModifier plans `private_key` as null when an existing public key is imported,
+// so the private key is never expected in state.
+type loginKeyPrivateKeyPlanModifier struct{}
+
+func (m loginKeyPrivateKeyPlanModifier) Description(_ context.Context) string {
+	return "Plans the private key as null when public_key is set."
+}
+
+func (m loginKeyPrivateKeyPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m loginKeyPrivateKeyPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	var publicKey types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("public_key"), &publicKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !publicKey.IsNull() && !publicKey.IsUnknown() {
+		resp.PlanValue = types.StringNull()
+		return
+	}
 }
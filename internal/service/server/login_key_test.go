@@ -55,6 +55,40 @@ func TestAccResourceNcloudLoginKey_vpc_basic(t *testing.T) {
 	})
 }
 
+func TestAccResourceNcloudLoginKey_vpc_publicKey(t *testing.T) {
+	var loginKey *server.LoginKey
+	prefix := GetTestPrefix()
+	testKeyName := prefix + "-key"
+	resourceName := "ncloud_login_key.loginkey"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccCheckLoginKeyDestroyWithProvider(state, TestAccProvider)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoginKeyConfigPublicKey(testKeyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoginKeyExistsWithProvider(resourceName, loginKey, TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "key_name", testKeyName),
+					resource.TestCheckResourceAttr(resourceName, "public_key", testAccLoginKeyPublicKey),
+					resource.TestCheckResourceAttr(resourceName, "public_key_fingerprint", "SHA256:czqWPyWQhAxKuFfai8zJ06jIeEBnxtSa0IVSLyJ6Li8"),
+					resource.TestCheckNoResourceAttr(resourceName, "private_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "fingerprint"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key_name", "public_key", "public_key_fingerprint"},
+			},
+		},
+	})
+}
+
 func testAccCheckLoginKeyExistsWithProvider(n string, l *server.LoginKey, provider *schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -111,3 +145,14 @@ resource "ncloud_login_key" "loginkey" {
 }
 `, keyName)
 }
+
+const testAccLoginKeyPublicKey = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCfF/ErOGw/utuzZGPliiuVXV4qXrC9k91ZOG6NloizjQSbpKSit46l32ygC6DFGqjyqUQckPXj+8pf+LfUXG41kuiLfv2nJkeK1bBMW7vaTpBnoirj+yslDBdIsRcHSlj9rqPfsibTiyV3eGhJfv8IgYrwAnPcX3ppG4vUdNyceWqMJ0XxG6p5Hm1YMm9oyBV7Tw/amzDJ1CXaMRkKQYdbU11i5GTDDab/1QuNy0JpZfxOKEYzrVGpx+slXg4MXyFtZD2F6k292+dEbLoD4pFUXuTuAXHqApdBZihZhFNOWYb7A2Un5E+2UrkqL5tz7lDVhyDRl3C44bB8NYbzr3FJ fixture"
+
+func testAccLoginKeyConfigPublicKey(keyName string) string {
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "loginkey" {
+	key_name   = "%s"
+	public_key = "%s"
+}
+`, keyName, testAccLoginKeyPublicKey)
+}
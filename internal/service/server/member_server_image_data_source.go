@@ -1,6 +1,7 @@
 package server
 
 import (
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -35,6 +36,7 @@ func DataSourceNcloudMemberServerImage() *schema.Resource {
 				Description:      "A regex string to apply to the member server image list returned by ncloud",
 				Deprecated:       "use filter instead",
 			},
+			"most_recent": DataSourceMostRecentSchema(),
 			"region": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -97,6 +99,11 @@ func DataSourceNcloudMemberServerImage() *schema.Resource {
 				Computed:    true,
 				Description: "Member server image platform type",
 			},
+			"create_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Member server image creation date",
+			},
 			"block_storage_total_rows": {
 				Type:        schema.TypeInt,
 				Computed:    true,
@@ -125,6 +132,13 @@ func dataSourceNcloudMemberServerImageRead(d *schema.ResourceData, meta interfac
 		resources = ApplyFilters(f.(*schema.Set), resources, DataSourceNcloudMemberServerImage().Schema)
 	}
 
+	resources, err = SelectImages(resources, d.Get("name_regex").(string), d.Get("most_recent").(bool), func(m map[string]interface{}) ImageSortKey {
+		return ImageSortKey{Name: m["name"].(string), CreateDate: m["create_date"].(string)}
+	})
+	if err != nil {
+		return err
+	}
+
 	if err := verify.ValidateOneResult(len(resources)); err != nil {
 		return err
 	}
@@ -169,6 +183,7 @@ func getVpcMemberServerImage(d *schema.ResourceData, config *conn.ProviderConfig
 			"description":                        *r.MemberServerImageDescription,
 			"original_server_instance_no":        *r.OriginalServerInstanceNo,
 			"original_server_image_product_code": *r.OriginalServerImageProductCode,
+			"create_date":                        ncloud.StringValue(r.CreateDate),
 		}
 
 		if r.MemberServerImageBlockStorageTotalRows != nil {
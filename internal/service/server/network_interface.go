@@ -1,10 +1,12 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
@@ -35,6 +37,14 @@ func ResourceNcloudNetworkInterface() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customdiff.All(
+			customdiff.ComputedIf("secondary_private_ip_count", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChange("secondary_private_ips")
+			}),
+			customdiff.ComputedIf("secondary_private_ips", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChange("secondary_private_ip_count")
+			}),
+		),
 		Schema: map[string]*schema.Schema{
 			"subnet_no": {
 				Type:     schema.TypeString,
@@ -68,6 +78,23 @@ func ResourceNcloudNetworkInterface() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"secondary_private_ips": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"secondary_private_ip_count"},
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IsIPv4Address),
+				},
+			},
+			"secondary_private_ip_count": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				ConflictsWith:    []string{"secondary_private_ips"},
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			},
 			"description": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -139,6 +166,8 @@ func resourceNcloudNetworkInterfaceRead(d *schema.ResourceData, meta interface{}
 	d.Set("status", instance.NetworkInterfaceStatus.Code)
 	d.Set("access_control_groups", instance.AccessControlGroupNoList)
 	d.Set("is_default", instance.IsDefault)
+	d.Set("secondary_private_ips", instance.SecondaryIpList)
+	d.Set("secondary_private_ip_count", len(instance.SecondaryIpList))
 
 	if instance.InstanceType != nil {
 		d.Set("instance_type", instance.InstanceType.Code)
@@ -190,9 +219,98 @@ func resourceNcloudNetworkInterfaceUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
+	// Both attributes are marked unknown when the other one changes, so only the configured one is applied
+	if d.HasChange("secondary_private_ips") && !d.GetRawConfig().GetAttr("secondary_private_ips").IsNull() {
+		o, n := d.GetChange("secondary_private_ips")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		if remove := ExpandStringInterfaceList(os.Difference(ns).List()); len(remove) > 0 {
+			if err := unassignNetworkInterfaceSecondaryIps(d, config, remove); err != nil {
+				return err
+			}
+		}
+
+		if add := ExpandStringInterfaceList(ns.Difference(os).List()); len(add) > 0 {
+			reqParams := &vserver.AssignSecondaryIpsRequest{
+				SecondaryIpList: add,
+			}
+			if err := assignNetworkInterfaceSecondaryIps(d, config, reqParams); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("secondary_private_ip_count") && !d.GetRawConfig().GetAttr("secondary_private_ip_count").IsNull() {
+		if err := updateNetworkInterfaceSecondaryIpCount(d, config); err != nil {
+			return err
+		}
+	}
+
 	return resourceNcloudNetworkInterfaceRead(d, meta)
 }
 
+func updateNetworkInterfaceSecondaryIpCount(d *schema.ResourceData, config *conn.ProviderConfig) error {
+	instance, err := GetNetworkInterface(config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if instance == nil {
+		return fmt.Errorf("no matching network interface [%s] found", d.Id())
+	}
+
+	current := len(instance.SecondaryIpList)
+	count := d.Get("secondary_private_ip_count").(int)
+
+	if count > current {
+		reqParams := &vserver.AssignSecondaryIpsRequest{
+			SecondaryIpCount: ncloud.Int32(int32(count - current)),
+		}
+		return assignNetworkInterfaceSecondaryIps(d, config, reqParams)
+	}
+
+	if count < current {
+		// Release the most recently assigned secondary IPs first
+		return unassignNetworkInterfaceSecondaryIps(d, config, instance.SecondaryIpList[count:])
+	}
+
+	return nil
+}
+
+func assignNetworkInterfaceSecondaryIps(d *schema.ResourceData, config *conn.ProviderConfig, reqParams *vserver.AssignSecondaryIpsRequest) error {
+	reqParams.RegionCode = &config.RegionCode
+	reqParams.NetworkInterfaceNo = ncloud.String(d.Id())
+
+	LogCommonRequest("AssignSecondaryIps", reqParams)
+	resp, err := config.Client.Vserver.V2Api.AssignSecondaryIps(reqParams)
+	if err != nil {
+		LogErrorResponse("AssignSecondaryIps", err, reqParams)
+		return err
+	}
+	LogResponse("AssignSecondaryIps", resp)
+
+	return waitForVpcNetworkInterfaceState(config, d.Id(), []string{NetworkInterfaceStateSet}, []string{NetworkInterfaceStateNotUsed, NetworkInterfaceStateUsed})
+}
+
+func unassignNetworkInterfaceSecondaryIps(d *schema.ResourceData, config *conn.ProviderConfig, secondaryIpList []*string) error {
+	reqParams := &vserver.UnassignSecondaryIpsRequest{
+		RegionCode:         &config.RegionCode,
+		NetworkInterfaceNo: ncloud.String(d.Id()),
+		SecondaryIpList:    secondaryIpList,
+	}
+
+	LogCommonRequest("UnassignSecondaryIps", reqParams)
+	resp, err := config.Client.Vserver.V2Api.UnassignSecondaryIps(reqParams)
+	if err != nil {
+		LogErrorResponse("UnassignSecondaryIps", err, reqParams)
+		return err
+	}
+	LogResponse("UnassignSecondaryIps", resp)
+
+	return waitForVpcNetworkInterfaceState(config, d.Id(), []string{NetworkInterfaceStateSet}, []string{NetworkInterfaceStateNotUsed, NetworkInterfaceStateUsed})
+}
+
 func removeNetworkInterfaceAccessControlGroup(d *schema.ResourceData, config *conn.ProviderConfig, accessControlGroupNoList []*string) error {
 	var resp *vserver.RemoveNetworkInterfaceAccessControlGroupResponse
 	var reqParams *vserver.RemoveNetworkInterfaceAccessControlGroupRequest
@@ -310,6 +428,14 @@ func createNetworkInterface(d *schema.ResourceData, config *conn.ProviderConfig)
 		Ip:                          StringPtrOrNil(d.GetOk("private_ip")),
 	}
 
+	if v, ok := d.GetOk("secondary_private_ips"); ok {
+		reqParams.SecondaryIpList = ExpandStringInterfaceList(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("secondary_private_ip_count"); ok {
+		reqParams.SecondaryIpCount = ncloud.Int32(int32(v.(int)))
+	}
+
 	LogCommonRequest("createVpcNetworkInterface", reqParams)
 	resp, err := config.Client.Vserver.V2Api.CreateNetworkInterface(reqParams)
 	if err != nil {
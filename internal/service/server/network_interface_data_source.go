@@ -91,6 +91,9 @@ func getVpcNetworkInterfaceListFiltered(d *schema.ResourceData, config *conn.Pro
 			instance["access_control_groups"] = StringPtrArrToStringArr(r.AccessControlGroupNoList)
 		}
 
+		instance["secondary_private_ips"] = StringPtrArrToStringArr(r.SecondaryIpList)
+		instance["secondary_private_ip_count"] = len(r.SecondaryIpList)
+
 		if r.InstanceType != nil {
 			instance["instance_type"] = *r.InstanceType.Code
 		}
@@ -79,6 +79,55 @@ func TestAccresourceNcloudNetworkInterface_update(t *testing.T) {
 	})
 }
 
+func TestAccresourceNcloudNetworkInterface_secondaryIps(t *testing.T) {
+	var before, after vserver.NetworkInterface
+	resourceName := "ncloud_network_interface.foo"
+	name := fmt.Sprintf("tf-nic-secondary-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInterfaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudNetworkInterfaceSecondaryIps(name, `secondary_private_ips = ["10.4.0.10", "10.4.0.11"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInterfaceExists(resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ips.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "secondary_private_ips.*", "10.4.0.10"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "secondary_private_ips.*", "10.4.0.11"),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ip_count", "2"),
+				),
+			},
+			{
+				Config: testAccResourceNcloudNetworkInterfaceSecondaryIps(name, `secondary_private_ips = ["10.4.0.11", "10.4.0.12"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInterfaceExists(resourceName, &after),
+					testAccCheckNetworkInterfaceNotRecreated(&before, &after),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ips.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "secondary_private_ips.*", "10.4.0.12"),
+				),
+			},
+			{
+				Config: testAccResourceNcloudNetworkInterfaceSecondaryIps(name, `secondary_private_ip_count = 3`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInterfaceExists(resourceName, &after),
+					testAccCheckNetworkInterfaceNotRecreated(&before, &after),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ips.#", "3"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "secondary_private_ips.*", "10.4.0.11"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "secondary_private_ips.*", "10.4.0.12"),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ip_count", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccresourceNcloudNetworkInterface_disappears(t *testing.T) {
 	var networkInterface vserver.NetworkInterface
 	name := fmt.Sprintf("tf-nic-disappear-%s", acctest.RandString(5))
@@ -128,6 +177,33 @@ resource "ncloud_network_interface" "foo" {
 `, name)
 }
 
+func testAccResourceNcloudNetworkInterfaceSecondaryIps(name, secondaryIps string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "test" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.4.0.0/16"
+}
+
+resource "ncloud_subnet" "test" {
+	vpc_no             = ncloud_vpc.test.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.4.0.0/24"
+	zone               = "KR-1"
+	network_acl_no     = ncloud_vpc.test.default_network_acl_no
+	subnet_type        = "PUBLIC"
+	usage_type         = "GEN"
+}
+
+resource "ncloud_network_interface" "foo" {
+	name                  = "%[1]s"
+	subnet_no             = ncloud_subnet.test.id
+	private_ip            = "10.4.0.6"
+	access_control_groups = [ncloud_vpc.test.default_access_control_group_no]
+	%[2]s
+}
+`, name, secondaryIps)
+}
+
 func testAccResourceNcloudNetworkInterfaceUpdate(name, instanceNo string) string {
 	return fmt.Sprintf(`
 resource "ncloud_vpc" "test" {
@@ -191,6 +267,15 @@ func testAccCheckNetworkInterfaceExists(n string, NetworkInterface *vserver.Netw
 	}
 }
 
+func testAccCheckNetworkInterfaceNotRecreated(before, after *vserver.NetworkInterface) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if *before.NetworkInterfaceNo != *after.NetworkInterfaceNo {
+			return fmt.Errorf("Network Interface IDs have changed. Before %s. After %s", *before.NetworkInterfaceNo, *after.NetworkInterfaceNo)
+		}
+		return nil
+	}
+}
+
 func testAccCheckNetworkInterfaceDestroy(s *terraform.State) error {
 	config := TestAccProvider.Meta().(*conn.ProviderConfig)
 
@@ -0,0 +1,464 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	vpcservice "github.com/terraform-providers/terraform-provider-ncloud/internal/service/vpc"
+)
+
+// Components crossed by traffic between two endpoints, in the order they are evaluated.
+const (
+	pathComponentSourceAccessControlGroup      = "SOURCE_ACG"
+	pathComponentSourceNetworkACL              = "SOURCE_NETWORK_ACL"
+	pathComponentRouteTable                    = "ROUTE_TABLE"
+	pathComponentDestinationNetworkACL         = "DESTINATION_NETWORK_ACL"
+	pathComponentDestinationAccessControlGroup = "DESTINATION_ACG"
+	pathComponentDestinationNetworkACLReturn   = "DESTINATION_NETWORK_ACL_RETURN"
+	pathComponentSourceNetworkACLReturn        = "SOURCE_NETWORK_ACL_RETURN"
+)
+
+// Ephemeral ports return traffic is sent to, covering both the Linux (32768-60999) and the Windows (49152-65535) defaults.
+const (
+	pathEphemeralPortMin = 32768
+	pathEphemeralPortMax = 65535
+)
+
+const (
+	pathVerdictAllow = "ALLOW"
+	pathVerdictDeny  = "DENY"
+)
+
+// pathEndpoint is one end of an analyzed path.
+// Endpoints that aren't found in the account, such as internet addresses, only have an IP.
+type pathEndpoint struct {
+	ip                    net.IP
+	subnet                *vpc.Subnet
+	accessControlGroupNos []string
+}
+
+type pathStep struct {
+	component  string
+	resourceNo string
+	verdict    string
+	detail     string
+}
+
+// pathAnalysisLoader reads the network configuration evaluated by analyzePath.
+type pathAnalysisLoader interface {
+	accessControlGroupRules(accessControlGroupNo string) ([]*vserver.AccessControlGroupRule, error)
+	networkACLRules(networkACLNo string) ([]*vpc.NetworkAclRule, error)
+	denyAllowGroupIpList(denyAllowGroupNo string) ([]string, error)
+	subnetRoutes(subnet *vpc.Subnet) (string, []*vpc.Route, error)
+	natGateway(natGatewayNo string) (*vpc.NatGatewayInstance, error)
+	vpcPeering(vpcPeeringNo string) (*vpc.VpcPeeringInstance, error)
+}
+
+// analyzePath walks the components between source and destination and stops at the first one denying the traffic.
+// ACGs are stateful, but Network ACLs aren't, so return traffic to the source's ephemeral ports is checked against
+// the outbound rules of the destination's Network ACL and the inbound rules of the source's one. The route back is not checked.
+func analyzePath(l pathAnalysisLoader, source, destination *pathEndpoint, protocol string, port int) ([]pathStep, error) {
+	checks := []func() (*pathStep, error){
+		func() (*pathStep, error) {
+			return checkPathAccessControlGroups(l, pathComponentSourceAccessControlGroup, "OTBND", source, destination, protocol, port)
+		},
+		func() (*pathStep, error) {
+			return checkPathNetworkACL(l, pathComponentSourceNetworkACL, "OTBND", source, destination, protocol, port, port)
+		},
+		func() (*pathStep, error) {
+			return checkPathRoute(l, source, destination)
+		},
+		func() (*pathStep, error) {
+			return checkPathNetworkACL(l, pathComponentDestinationNetworkACL, "INBND", destination, source, protocol, port, port)
+		},
+		func() (*pathStep, error) {
+			return checkPathAccessControlGroups(l, pathComponentDestinationAccessControlGroup, "INBND", destination, source, protocol, port)
+		},
+		func() (*pathStep, error) {
+			return checkPathNetworkACL(l, pathComponentDestinationNetworkACLReturn, "OTBND", destination, source, protocol, pathEphemeralPortMin, pathEphemeralPortMax)
+		},
+		func() (*pathStep, error) {
+			return checkPathNetworkACL(l, pathComponentSourceNetworkACLReturn, "INBND", source, destination, protocol, pathEphemeralPortMin, pathEphemeralPortMax)
+		},
+	}
+
+	var steps []pathStep
+	for _, check := range checks {
+		step, err := check()
+		if err != nil {
+			return nil, err
+		}
+
+		if step == nil {
+			continue
+		}
+
+		steps = append(steps, *step)
+		if step.verdict == pathVerdictDeny {
+			break
+		}
+	}
+
+	return steps, nil
+}
+
+// checkPathAccessControlGroups looks for a rule of the endpoint's ACGs allowing traffic with the peer.
+// ACGs only hold allow rules. An ACG without outbound rules allows all outbound traffic.
+func checkPathAccessControlGroups(l pathAnalysisLoader, component, ruleType string, endpoint, peer *pathEndpoint, protocol string, port int) (*pathStep, error) {
+	if len(endpoint.accessControlGroupNos) == 0 {
+		return nil, nil
+	}
+
+	hasRules := false
+	for _, accessControlGroupNo := range endpoint.accessControlGroupNos {
+		rules, err := l.accessControlGroupRules(accessControlGroupNo)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range rules {
+			if ncloud.StringValue(common.GetCodePtrByCommonCode(r.AccessControlGroupRuleType)) != ruleType {
+				continue
+			}
+			hasRules = true
+
+			var ruleProtocol string
+			if r.ProtocolType != nil {
+				ruleProtocol = ncloud.StringValue(r.ProtocolType.Code)
+			}
+
+			if ruleProtocol != protocol || !matchPathPortRange(ncloud.StringValue(r.PortRange), port) {
+				continue
+			}
+
+			peerSpec := ncloud.StringValue(r.IpBlock)
+			if len(peerSpec) > 0 && !matchPathIpBlock(peerSpec, peer.ip) {
+				continue
+			}
+
+			if sourceAccessControlGroupNo := ncloud.StringValue(r.AccessControlGroupSequence); len(sourceAccessControlGroupNo) > 0 {
+				if !slices.Contains(peer.accessControlGroupNos, sourceAccessControlGroupNo) {
+					continue
+				}
+				peerSpec = "ACG " + sourceAccessControlGroupNo
+			}
+
+			return &pathStep{
+				component:  component,
+				resourceNo: accessControlGroupNo,
+				verdict:    pathVerdictAllow,
+				detail:     fmt.Sprintf("%s rule %s %s %s allows the traffic", pathRuleTypeName(ruleType), ruleProtocol, ncloud.StringValue(r.PortRange), peerSpec),
+			}, nil
+		}
+	}
+
+	accessControlGroupNos := strings.Join(endpoint.accessControlGroupNos, ",")
+
+	if ruleType == "OTBND" && !hasRules {
+		return &pathStep{
+			component:  component,
+			resourceNo: accessControlGroupNos,
+			verdict:    pathVerdictAllow,
+			detail:     "no outbound rule is set, so all outbound traffic is allowed",
+		}, nil
+	}
+
+	return &pathStep{
+		component:  component,
+		resourceNo: accessControlGroupNos,
+		verdict:    pathVerdictDeny,
+		detail:     fmt.Sprintf("no %s rule allows %s %s with %s", pathRuleTypeName(ruleType), protocol, pathPortString(protocol, port), peer.ip),
+	}, nil
+}
+
+// checkPathNetworkACL evaluates the rules of the Network ACL of the endpoint's subnet by priority for the ports portMin to portMax.
+// Network ACLs only apply to traffic crossing the subnet boundary, and traffic matching no rule is allowed.
+// The first rule overlapping the ports decides, and an ALLOW rule only allowing some of them denies the rest.
+func checkPathNetworkACL(l pathAnalysisLoader, component, ruleType string, endpoint, peer *pathEndpoint, protocol string, portMin, portMax int) (*pathStep, error) {
+	if endpoint.subnet == nil {
+		return nil, nil
+	}
+
+	if peer.subnet != nil && ncloud.StringValue(peer.subnet.SubnetNo) == ncloud.StringValue(endpoint.subnet.SubnetNo) {
+		return nil, nil
+	}
+
+	networkACLNo := ncloud.StringValue(endpoint.subnet.NetworkAclNo)
+	rules, err := l.networkACLRules(networkACLNo)
+	if err != nil {
+		return nil, err
+	}
+
+	rules = slices.Clone(rules)
+	sort.SliceStable(rules, func(i, j int) bool {
+		return ncloud.Int32Value(rules[i].Priority) < ncloud.Int32Value(rules[j].Priority)
+	})
+
+	for _, r := range rules {
+		if ncloud.StringValue(common.GetCodePtrByCommonCode(r.NetworkAclRuleType)) != ruleType {
+			continue
+		}
+
+		ruleProtocol := ncloud.StringValue(common.GetCodePtrByCommonCode(r.ProtocolType))
+		ruleMin, ruleMax, ok := pathPortRangeBounds(ncloud.StringValue(r.PortRange))
+		if ruleProtocol != protocol || !ok || ruleMax < portMin || ruleMin > portMax {
+			continue
+		}
+
+		peerSpec := ncloud.StringValue(r.IpBlock)
+		if denyAllowGroupNo := ncloud.StringValue(r.DenyAllowGroupNo); len(denyAllowGroupNo) > 0 {
+			ipList, err := l.denyAllowGroupIpList(denyAllowGroupNo)
+			if err != nil {
+				return nil, err
+			}
+
+			if !slices.ContainsFunc(ipList, func(ipBlock string) bool { return matchPathIpBlock(ipBlock, peer.ip) }) {
+				continue
+			}
+			peerSpec = "deny-allow group " + denyAllowGroupNo
+		} else if !matchPathIpBlock(peerSpec, peer.ip) {
+			continue
+		}
+
+		verdict := pathVerdictAllow
+		action := ncloud.StringValue(common.GetCodePtrByCommonCode(r.RuleAction))
+		if action != "ALLOW" {
+			verdict = pathVerdictDeny
+		}
+
+		detail := fmt.Sprintf("%s rule with priority %d (%s %s %s %s) matches the traffic", pathRuleTypeName(ruleType),
+			ncloud.Int32Value(r.Priority), ruleProtocol, ncloud.StringValue(r.PortRange), peerSpec, action)
+		if verdict == pathVerdictAllow && (ruleMin > portMin || ruleMax < portMax) {
+			verdict = pathVerdictDeny
+			detail = fmt.Sprintf("%s rule with priority %d (%s %s %s %s) only allows part of ports %d-%d", pathRuleTypeName(ruleType),
+				ncloud.Int32Value(r.Priority), ruleProtocol, ncloud.StringValue(r.PortRange), peerSpec, action, portMin, portMax)
+		}
+
+		return &pathStep{
+			component:  component,
+			resourceNo: networkACLNo,
+			verdict:    verdict,
+			detail:     detail,
+		}, nil
+	}
+
+	return &pathStep{
+		component:  component,
+		resourceNo: networkACLNo,
+		verdict:    pathVerdictAllow,
+		detail:     fmt.Sprintf("no %s rule matches the traffic, so it is allowed", pathRuleTypeName(ruleType)),
+	}, nil
+}
+
+// checkPathRoute picks the most specific route of the source subnet's Route Table towards the destination
+// and checks that its target can forward the traffic.
+func checkPathRoute(l pathAnalysisLoader, source, destination *pathEndpoint) (*pathStep, error) {
+	if source.subnet == nil {
+		return nil, nil
+	}
+
+	routeTableNo, routes, err := l.subnetRoutes(source.subnet)
+	if err != nil {
+		return nil, err
+	}
+
+	step := &pathStep{
+		component:  pathComponentRouteTable,
+		resourceNo: routeTableNo,
+		verdict:    pathVerdictDeny,
+	}
+
+	route := longestPrefixRoute(routes, destination.ip)
+	if route == nil {
+		step.detail = fmt.Sprintf("no route matches %s", destination.ip)
+		return step, nil
+	}
+
+	targetType := ncloud.StringValue(common.GetCodePtrByCommonCode(route.TargetType))
+	targetNo := ncloud.StringValue(route.TargetNo)
+	routeSpec := fmt.Sprintf("route %s to %s %s", ncloud.StringValue(route.DestinationCidrBlock), targetType, ncloud.StringValue(route.TargetName))
+
+	switch targetType {
+	case "NATGW":
+		natGateway, err := l.natGateway(targetNo)
+		if err != nil {
+			return nil, err
+		}
+
+		if natGateway == nil {
+			step.detail = fmt.Sprintf("%s targets NAT Gateway (%s), which doesn't exist", routeSpec, targetNo)
+			return step, nil
+		}
+
+		if ncloud.StringValue(common.GetCodePtrByCommonCode(natGateway.NatGatewayType)) == "PRIVATE" && !destination.ip.IsPrivate() {
+			step.detail = fmt.Sprintf("%s targets a PRIVATE NAT Gateway, which can't reach public address %s", routeSpec, destination.ip)
+			return step, nil
+		}
+	case "VPCPEERING":
+		vpcPeering, err := l.vpcPeering(targetNo)
+		if err != nil {
+			return nil, err
+		}
+
+		if vpcPeering == nil {
+			step.detail = fmt.Sprintf("%s targets VPC Peering (%s), which doesn't exist", routeSpec, targetNo)
+			return step, nil
+		}
+
+		if status := ncloud.StringValue(common.GetCodePtrByCommonCode(vpcPeering.VpcPeeringInstanceStatus)); status != "RUN" {
+			step.detail = fmt.Sprintf("%s targets VPC Peering (%s), which is not running (%s)", routeSpec, targetNo, status)
+			return step, nil
+		}
+
+		if destination.subnet != nil && ncloud.StringValue(vpcPeering.TargetVpcNo) != ncloud.StringValue(destination.subnet.VpcNo) {
+			step.detail = fmt.Sprintf("%s targets VPC Peering (%s), which leads to VPC (%s) instead of the destination VPC (%s)",
+				routeSpec, targetNo, ncloud.StringValue(vpcPeering.TargetVpcNo), ncloud.StringValue(destination.subnet.VpcNo))
+			return step, nil
+		}
+	}
+
+	step.verdict = pathVerdictAllow
+	step.detail = routeSpec + " forwards the traffic"
+
+	return step, nil
+}
+
+func longestPrefixRoute(routes []*vpc.Route, ip net.IP) *vpc.Route {
+	var match *vpc.Route
+	matchSize := -1
+
+	for _, r := range routes {
+		_, ipNet, err := net.ParseCIDR(ncloud.StringValue(r.DestinationCidrBlock))
+		if err != nil || !ipNet.Contains(ip) {
+			continue
+		}
+
+		if size, _ := ipNet.Mask.Size(); size > matchSize {
+			match = r
+			matchSize = size
+		}
+	}
+
+	return match
+}
+
+// matchPathIpBlock reports whether ip is in ipBlock, which is either a CIDR block or a single IP.
+func matchPathIpBlock(ipBlock string, ip net.IP) bool {
+	if _, ipNet, err := net.ParseCIDR(ipBlock); err == nil {
+		return ipNet.Contains(ip)
+	}
+
+	return net.ParseIP(ipBlock).Equal(ip)
+}
+
+// matchPathPortRange reports whether port is in portRange, formatted as "22" or "1-65535".
+// Rules without a port range, such as ICMP rules, match any port.
+func matchPathPortRange(portRange string, port int) bool {
+	min, max, ok := pathPortRangeBounds(portRange)
+
+	return ok && port >= min && port <= max
+}
+
+// pathPortRangeBounds returns the first and last port of portRange. An empty range covers every port.
+func pathPortRangeBounds(portRange string) (int, int, bool) {
+	if len(portRange) == 0 {
+		return 0, 65535, true
+	}
+
+	from, to, found := strings.Cut(portRange, "-")
+	if !found {
+		to = from
+	}
+
+	min, err := strconv.Atoi(strings.TrimSpace(from))
+	if err != nil {
+		return 0, 0, false
+	}
+
+	max, err := strconv.Atoi(strings.TrimSpace(to))
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return min, max, true
+}
+
+func pathRuleTypeName(ruleType string) string {
+	if ruleType == "INBND" {
+		return "inbound"
+	}
+	return "outbound"
+}
+
+func pathPortString(protocol string, port int) string {
+	if protocol == "ICMP" {
+		return "traffic"
+	}
+	return fmt.Sprintf("port %d", port)
+}
+
+// configPathAnalysisLoader reads the network configuration from the API.
+type configPathAnalysisLoader struct {
+	ctx    context.Context
+	config *conn.ProviderConfig
+}
+
+func (c *configPathAnalysisLoader) accessControlGroupRules(accessControlGroupNo string) ([]*vserver.AccessControlGroupRule, error) {
+	return GetAccessControlGroupRuleList(c.config, accessControlGroupNo)
+}
+
+func (c *configPathAnalysisLoader) networkACLRules(networkACLNo string) ([]*vpc.NetworkAclRule, error) {
+	return vpcservice.GetNetworkACLRuleList(c.config, networkACLNo)
+}
+
+func (c *configPathAnalysisLoader) denyAllowGroupIpList(denyAllowGroupNo string) ([]string, error) {
+	denyAllowGroup, err := vpcservice.GetNetworkAclDenyAllowGroupDetail(c.config, denyAllowGroupNo)
+	if err != nil {
+		return nil, err
+	}
+
+	if denyAllowGroup == nil {
+		return nil, nil
+	}
+
+	return ncloud.StringListValue(denyAllowGroup.IpList), nil
+}
+
+func (c *configPathAnalysisLoader) subnetRoutes(subnet *vpc.Subnet) (string, []*vpc.Route, error) {
+	vpcNo := ncloud.StringValue(subnet.VpcNo)
+
+	routeTableNo, err := vpcservice.GetSubnetRouteTableNo(c.config, vpcNo, ncloud.StringValue(subnet.SubnetNo))
+	if err != nil {
+		return "", nil, err
+	}
+
+	if len(routeTableNo) == 0 {
+		return "", nil, nil
+	}
+
+	routes, err := vpcservice.GetRouteList(c.config, vpcNo, routeTableNo)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return routeTableNo, routes, nil
+}
+
+func (c *configPathAnalysisLoader) natGateway(natGatewayNo string) (*vpc.NatGatewayInstance, error) {
+	return vpcservice.GetNatGatewayInstance(c.ctx, c.config, natGatewayNo)
+}
+
+func (c *configPathAnalysisLoader) vpcPeering(vpcPeeringNo string) (*vpc.VpcPeeringInstance, error) {
+	return vpcservice.GetVpcPeeringInstance(c.ctx, c.config, vpcPeeringNo)
+}
@@ -0,0 +1,349 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/mysql"
+	vpcservice "github.com/terraform-providers/terraform-provider-ncloud/internal/service/vpc"
+)
+
+var (
+	_ datasource.DataSource              = &networkPathAnalysisDataSource{}
+	_ datasource.DataSourceWithConfigure = &networkPathAnalysisDataSource{}
+)
+
+func NewNetworkPathAnalysisDataSource() datasource.DataSource {
+	return &networkPathAnalysisDataSource{}
+}
+
+// networkPathAnalysisDataSource checks whether traffic can flow between two endpoints
+// by evaluating the ACGs, Network ACLs and routes that apply to it. Nothing is sent on the network.
+type networkPathAnalysisDataSource struct {
+	config *conn.ProviderConfig
+}
+
+func (n *networkPathAnalysisDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_path_analysis"
+}
+
+func networkPathEndpointAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Required:    true,
+		Description: description,
+		Attributes: map[string]schema.Attribute{
+			"server_instance_no": schema.StringAttribute{
+				Optional:    true,
+				Description: "Server, analyzed through its default network interface.",
+			},
+			"network_interface_no": schema.StringAttribute{
+				Optional: true,
+			},
+			"mysql_instance_no": schema.StringAttribute{
+				Optional:    true,
+				Description: "Cloud DB for MySQL instance, analyzed through its master server.",
+			},
+			"ip": schema.StringAttribute{
+				Optional:    true,
+				Description: "IP address. An IP that doesn't belong to a network interface of the account is treated as an external address.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName("server_instance_no"),
+						path.MatchRelative().AtParent().AtName("network_interface_no"),
+						path.MatchRelative().AtParent().AtName("mysql_instance_no"),
+						path.MatchRelative().AtParent().AtName("ip"),
+					),
+				},
+			},
+		},
+	}
+}
+
+func (n *networkPathAnalysisDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"source":      networkPathEndpointAttribute("Endpoint sending the traffic."),
+			"destination": networkPathEndpointAttribute("Endpoint receiving the traffic."),
+			"protocol": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"TCP", "UDP", "ICMP"}...),
+				},
+			},
+			"port": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Destination port. Required unless `protocol` is `ICMP`.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"reachable": schema.BoolAttribute{
+				Computed: true,
+			},
+			"blocked_by": schema.StringAttribute{
+				Computed:    true,
+				Description: "Component denying the traffic, empty when `reachable` is true.",
+			},
+			"blocking_rule": schema.StringAttribute{
+				Computed:    true,
+				Description: "Explanation of the rule or route denying the traffic, empty when `reachable` is true.",
+			},
+			"steps": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"component": schema.StringAttribute{
+							Computed: true,
+						},
+						"resource_no": schema.StringAttribute{
+							Computed: true,
+						},
+						"verdict": schema.StringAttribute{
+							Computed: true,
+						},
+						"detail": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (n *networkPathAnalysisDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	n.config = config
+}
+
+func (n *networkPathAnalysisDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data networkPathAnalysisDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	protocol := data.Protocol.ValueString()
+	if protocol != "ICMP" && data.Port.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("port"), "Missing Attribute", fmt.Sprintf("port is required for %s", protocol))
+		return
+	}
+
+	source, err := resolvePathEndpoint(ctx, n.config, data.Source)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "Error Reading Source", err.Error())
+		return
+	}
+
+	destination, err := resolvePathEndpoint(ctx, n.config, data.Destination)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("destination"), "Error Reading Destination", err.Error())
+		return
+	}
+
+	steps, err := analyzePath(&configPathAnalysisLoader{ctx: ctx, config: n.config}, source, destination, protocol, int(data.Port.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("AnalyzeNetworkPath", err.Error())
+		return
+	}
+	tflog.Info(ctx, "AnalyzeNetworkPath result", map[string]any{
+		"steps": fmt.Sprintf("%+v", steps),
+	})
+
+	data.Reachable = types.BoolValue(true)
+	data.BlockedBy = types.StringValue("")
+	data.BlockingRule = types.StringValue("")
+	data.Steps = []networkPathStepModel{}
+	for _, s := range steps {
+		data.Steps = append(data.Steps, networkPathStepModel{
+			Component:  types.StringValue(s.component),
+			ResourceNo: types.StringValue(s.resourceNo),
+			Verdict:    types.StringValue(s.verdict),
+			Detail:     types.StringValue(s.detail),
+		})
+
+		if s.verdict == pathVerdictDeny {
+			data.Reachable = types.BoolValue(false)
+			data.BlockedBy = types.StringValue(s.component)
+			data.BlockingRule = types.StringValue(s.detail)
+		}
+	}
+
+	data.ID = types.StringValue(strings.Join([]string{source.ip.String(), destination.ip.String(), protocol, strconv.FormatInt(data.Port.ValueInt64(), 10)}, ":"))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// resolvePathEndpoint reads the IP, subnet and ACGs of the configured endpoint.
+func resolvePathEndpoint(ctx context.Context, config *conn.ProviderConfig, m *networkPathEndpointModel) (*pathEndpoint, error) {
+	switch {
+	case !m.NetworkInterfaceNo.IsNull():
+		networkInterface, err := GetNetworkInterface(config, m.NetworkInterfaceNo.ValueString())
+		if err != nil {
+			return nil, err
+		}
+
+		if networkInterface == nil {
+			return nil, fmt.Errorf("no matching network interface: %s", m.NetworkInterfaceNo.ValueString())
+		}
+
+		return networkInterfacePathEndpoint(config, networkInterface)
+	case !m.ServerInstanceNo.IsNull():
+		serverInstance, err := GetServerInstance(config, m.ServerInstanceNo.ValueString())
+		if err != nil {
+			return nil, err
+		}
+
+		if serverInstance == nil {
+			return nil, fmt.Errorf("no matching server instance: %s", m.ServerInstanceNo.ValueString())
+		}
+
+		var defaultNetworkInterface *ServerInstanceNetworkInterface
+		for _, ni := range serverInstance.NetworkInterfaceList {
+			if defaultNetworkInterface == nil || ncloud.Int32Value(ni.Order) < ncloud.Int32Value(defaultNetworkInterface.Order) {
+				defaultNetworkInterface = ni
+			}
+		}
+
+		if defaultNetworkInterface == nil {
+			return nil, fmt.Errorf("server instance (%s) has no network interface", m.ServerInstanceNo.ValueString())
+		}
+
+		networkInterface, err := GetNetworkInterface(config, ncloud.StringValue(defaultNetworkInterface.NetworkInterfaceNo))
+		if err != nil {
+			return nil, err
+		}
+
+		if networkInterface == nil {
+			return nil, fmt.Errorf("no matching network interface: %s", ncloud.StringValue(defaultNetworkInterface.NetworkInterfaceNo))
+		}
+
+		return networkInterfacePathEndpoint(config, networkInterface)
+	case !m.MysqlInstanceNo.IsNull():
+		mysqlInstance, err := mysql.GetMysqlInstance(ctx, config, m.MysqlInstanceNo.ValueString())
+		if err != nil {
+			return nil, err
+		}
+
+		if mysqlInstance == nil || len(mysqlInstance.CloudMysqlServerInstanceList) == 0 {
+			return nil, fmt.Errorf("no matching mysql instance: %s", m.MysqlInstanceNo.ValueString())
+		}
+
+		server := mysqlInstance.CloudMysqlServerInstanceList[0]
+		for _, s := range mysqlInstance.CloudMysqlServerInstanceList {
+			if ncloud.StringValue(common.GetCodePtrByCommonCode(s.CloudMysqlServerRole)) == "M" {
+				server = s
+				break
+			}
+		}
+
+		subnet, err := vpcservice.GetSubnetInstance(config, ncloud.StringValue(server.SubnetNo))
+		if err != nil {
+			return nil, err
+		}
+
+		return &pathEndpoint{
+			ip:                    net.ParseIP(ncloud.StringValue(server.PrivateIp)),
+			subnet:                subnet,
+			accessControlGroupNos: ncloud.StringListValue(mysqlInstance.AccessControlGroupNoList),
+		}, nil
+	default:
+		ip := net.ParseIP(m.Ip.ValueString())
+		if ip == nil {
+			return nil, fmt.Errorf("invalid IP address: %s", m.Ip.ValueString())
+		}
+
+		reqParams := &vserver.GetNetworkInterfaceListRequest{
+			RegionCode: &config.RegionCode,
+			Ip:         ncloud.String(ip.String()),
+		}
+
+		common.LogCommonRequest("GetNetworkInterfaceList", reqParams)
+		resp, err := config.Client.Vserver.V2Api.GetNetworkInterfaceList(reqParams)
+		if err != nil {
+			common.LogErrorResponse("GetNetworkInterfaceList", err, reqParams)
+			return nil, err
+		}
+		common.LogResponse("GetNetworkInterfaceList", resp)
+
+		for _, networkInterface := range resp.NetworkInterfaceList {
+			if ncloud.StringValue(networkInterface.Ip) == ip.String() {
+				return networkInterfacePathEndpoint(config, networkInterface)
+			}
+		}
+
+		return &pathEndpoint{ip: ip}, nil
+	}
+}
+
+func networkInterfacePathEndpoint(config *conn.ProviderConfig, networkInterface *vserver.NetworkInterface) (*pathEndpoint, error) {
+	subnet, err := vpcservice.GetSubnetInstance(config, ncloud.StringValue(networkInterface.SubnetNo))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pathEndpoint{
+		ip:                    net.ParseIP(ncloud.StringValue(networkInterface.Ip)),
+		subnet:                subnet,
+		accessControlGroupNos: ncloud.StringListValue(networkInterface.AccessControlGroupNoList),
+	}, nil
+}
+
+type networkPathAnalysisDataSourceModel struct {
+	ID           types.String              `tfsdk:"id"`
+	Source       *networkPathEndpointModel `tfsdk:"source"`
+	Destination  *networkPathEndpointModel `tfsdk:"destination"`
+	Protocol     types.String              `tfsdk:"protocol"`
+	Port         types.Int64               `tfsdk:"port"`
+	Reachable    types.Bool                `tfsdk:"reachable"`
+	BlockedBy    types.String              `tfsdk:"blocked_by"`
+	BlockingRule types.String              `tfsdk:"blocking_rule"`
+	Steps        []networkPathStepModel    `tfsdk:"steps"`
+}
+
+type networkPathEndpointModel struct {
+	ServerInstanceNo   types.String `tfsdk:"server_instance_no"`
+	NetworkInterfaceNo types.String `tfsdk:"network_interface_no"`
+	MysqlInstanceNo    types.String `tfsdk:"mysql_instance_no"`
+	Ip                 types.String `tfsdk:"ip"`
+}
+
+type networkPathStepModel struct {
+	Component  types.String `tfsdk:"component"`
+	ResourceNo types.String `tfsdk:"resource_no"`
+	Verdict    types.String `tfsdk:"verdict"`
+	Detail     types.String `tfsdk:"detail"`
+}
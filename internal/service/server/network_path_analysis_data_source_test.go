@@ -0,0 +1,122 @@
+package server_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+)
+
+func TestAccDataSourceNcloudNetworkPathAnalysis_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-path-%s", acctest.RandString(5))
+	allowedName := "data.ncloud_network_path_analysis.allowed"
+	blockedName := "data.ncloud_network_path_analysis.blocked"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNcloudNetworkPathAnalysisConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(allowedName, "reachable", "true"),
+					resource.TestCheckResourceAttr(allowedName, "blocked_by", ""),
+					resource.TestCheckResourceAttr(allowedName, "steps.#", "7"),
+					resource.TestCheckResourceAttr(blockedName, "reachable", "false"),
+					resource.TestCheckResourceAttr(blockedName, "blocked_by", "DESTINATION_ACG"),
+					resource.TestCheckResourceAttrPair(blockedName, "steps.4.resource_no", "ncloud_access_control_group.db", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceNcloudNetworkPathAnalysisConfig(name string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "test" {
+	name            = "%[1]s"
+	ipv4_cidr_block = "10.5.0.0/16"
+}
+
+resource "ncloud_subnet" "app" {
+	vpc_no         = ncloud_vpc.test.vpc_no
+	name           = "%[1]s-app"
+	subnet         = "10.5.1.0/24"
+	zone           = "KR-2"
+	network_acl_no = ncloud_vpc.test.default_network_acl_no
+	subnet_type    = "PRIVATE"
+	usage_type     = "GEN"
+}
+
+resource "ncloud_subnet" "db" {
+	vpc_no         = ncloud_vpc.test.vpc_no
+	name           = "%[1]s-db"
+	subnet         = "10.5.2.0/24"
+	zone           = "KR-2"
+	network_acl_no = ncloud_vpc.test.default_network_acl_no
+	subnet_type    = "PRIVATE"
+	usage_type     = "GEN"
+}
+
+resource "ncloud_access_control_group" "app" {
+	name   = "%[1]s-app"
+	vpc_no = ncloud_vpc.test.vpc_no
+}
+
+resource "ncloud_access_control_group" "db" {
+	name   = "%[1]s-db"
+	vpc_no = ncloud_vpc.test.vpc_no
+}
+
+resource "ncloud_access_control_group_rule" "db" {
+	access_control_group_no = ncloud_access_control_group.db.id
+
+	inbound {
+		protocol                       = "TCP"
+		port_range                     = "3306"
+		source_access_control_group_no = ncloud_access_control_group.app.id
+	}
+}
+
+resource "ncloud_network_interface" "app" {
+	name                  = "%[1]s-app"
+	subnet_no             = ncloud_subnet.app.id
+	access_control_groups = [ncloud_access_control_group.app.id]
+}
+
+resource "ncloud_network_interface" "db" {
+	name                  = "%[1]s-db"
+	subnet_no             = ncloud_subnet.db.id
+	access_control_groups = [ncloud_access_control_group.db.id]
+}
+
+data "ncloud_network_path_analysis" "allowed" {
+	source = {
+		network_interface_no = ncloud_network_interface.app.id
+	}
+	destination = {
+		network_interface_no = ncloud_network_interface.db.id
+	}
+	protocol = "TCP"
+	port     = 3306
+
+	depends_on = [ncloud_access_control_group_rule.db]
+}
+
+data "ncloud_network_path_analysis" "blocked" {
+	source = {
+		network_interface_no = ncloud_network_interface.app.id
+	}
+	destination = {
+		network_interface_no = ncloud_network_interface.db.id
+	}
+	protocol = "TCP"
+	port     = 5432
+
+	depends_on = [ncloud_access_control_group_rule.db]
+}
+`, name)
+}
@@ -0,0 +1,307 @@
+package server
+
+import (
+	"net"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+)
+
+type testPathAnalysisLoader struct {
+	accessControlGroupRuleList map[string][]*vserver.AccessControlGroupRule
+	networkACLRuleList         map[string][]*vpc.NetworkAclRule
+	denyAllowGroups            map[string][]string
+	routes                     []*vpc.Route
+	natGateways                map[string]*vpc.NatGatewayInstance
+}
+
+func (t *testPathAnalysisLoader) accessControlGroupRules(accessControlGroupNo string) ([]*vserver.AccessControlGroupRule, error) {
+	return t.accessControlGroupRuleList[accessControlGroupNo], nil
+}
+
+func (t *testPathAnalysisLoader) networkACLRules(networkACLNo string) ([]*vpc.NetworkAclRule, error) {
+	return t.networkACLRuleList[networkACLNo], nil
+}
+
+func (t *testPathAnalysisLoader) denyAllowGroupIpList(denyAllowGroupNo string) ([]string, error) {
+	return t.denyAllowGroups[denyAllowGroupNo], nil
+}
+
+func (t *testPathAnalysisLoader) subnetRoutes(subnet *vpc.Subnet) (string, []*vpc.Route, error) {
+	return "rt-1", t.routes, nil
+}
+
+func (t *testPathAnalysisLoader) natGateway(natGatewayNo string) (*vpc.NatGatewayInstance, error) {
+	return t.natGateways[natGatewayNo], nil
+}
+
+func (t *testPathAnalysisLoader) vpcPeering(vpcPeeringNo string) (*vpc.VpcPeeringInstance, error) {
+	return nil, nil
+}
+
+func testAccessControlGroupRule(ruleType, protocol, portRange, ipBlock, accessControlGroupSequence string) *vserver.AccessControlGroupRule {
+	return &vserver.AccessControlGroupRule{
+		AccessControlGroupRuleType: &vserver.CommonCode{Code: ncloud.String(ruleType)},
+		ProtocolType:               &vserver.ProtocolType{Code: ncloud.String(protocol)},
+		PortRange:                  ncloud.String(portRange),
+		IpBlock:                    ncloud.String(ipBlock),
+		AccessControlGroupSequence: ncloud.String(accessControlGroupSequence),
+	}
+}
+
+func testNetworkACLRule(ruleType string, priority int32, protocol, portRange, ipBlock, denyAllowGroupNo, action string) *vpc.NetworkAclRule {
+	return &vpc.NetworkAclRule{
+		NetworkAclRuleType: &vpc.CommonCode{Code: ncloud.String(ruleType)},
+		Priority:           ncloud.Int32(priority),
+		ProtocolType:       &vpc.CommonCode{Code: ncloud.String(protocol)},
+		PortRange:          ncloud.String(portRange),
+		IpBlock:            ncloud.String(ipBlock),
+		DenyAllowGroupNo:   ncloud.String(denyAllowGroupNo),
+		RuleAction:         &vpc.CommonCode{Code: ncloud.String(action)},
+	}
+}
+
+func testRoute(destinationCidrBlock, targetType, targetNo string) *vpc.Route {
+	return &vpc.Route{
+		DestinationCidrBlock: ncloud.String(destinationCidrBlock),
+		TargetType:           &vpc.CommonCode{Code: ncloud.String(targetType)},
+		TargetNo:             ncloud.String(targetNo),
+		TargetName:           ncloud.String(targetNo),
+	}
+}
+
+func TestAnalyzePath(t *testing.T) {
+	app := &pathEndpoint{
+		ip:                    net.ParseIP("10.0.1.10"),
+		subnet:                &vpc.Subnet{SubnetNo: ncloud.String("subnet-app"), VpcNo: ncloud.String("vpc-1"), NetworkAclNo: ncloud.String("nacl-app")},
+		accessControlGroupNos: []string{"acg-app"},
+	}
+	db := &pathEndpoint{
+		ip:                    net.ParseIP("10.0.2.20"),
+		subnet:                &vpc.Subnet{SubnetNo: ncloud.String("subnet-db"), VpcNo: ncloud.String("vpc-1"), NetworkAclNo: ncloud.String("nacl-db")},
+		accessControlGroupNos: []string{"acg-db"},
+	}
+	internet := &pathEndpoint{
+		ip: net.ParseIP("203.0.113.5"),
+	}
+
+	localRoutes := []*vpc.Route{
+		testRoute("10.0.0.0/16", "LOCAL", "vpc-1"),
+	}
+
+	cases := []struct {
+		name              string
+		loader            *testPathAnalysisLoader
+		destination       *pathEndpoint
+		port              int
+		expectedBlockedBy string
+		expectedSteps     int
+	}{
+		{
+			name: "allowed by the destination ACG referencing the source ACG",
+			loader: &testPathAnalysisLoader{
+				accessControlGroupRuleList: map[string][]*vserver.AccessControlGroupRule{
+					"acg-db": {testAccessControlGroupRule("INBND", "TCP", "3306", "", "acg-app")},
+				},
+				routes: localRoutes,
+			},
+			destination:   db,
+			port:          3306,
+			expectedSteps: 7,
+		},
+		{
+			name: "destination Network ACL only allows the service port outbound",
+			loader: &testPathAnalysisLoader{
+				accessControlGroupRuleList: map[string][]*vserver.AccessControlGroupRule{
+					"acg-db": {testAccessControlGroupRule("INBND", "TCP", "3306", "0.0.0.0/0", "")},
+				},
+				networkACLRuleList: map[string][]*vpc.NetworkAclRule{
+					"nacl-db": {
+						testNetworkACLRule("INBND", 10, "TCP", "3306", "10.0.1.0/24", "", "ALLOW"),
+						testNetworkACLRule("OTBND", 10, "TCP", "3306", "10.0.1.0/24", "", "ALLOW"),
+						testNetworkACLRule("OTBND", 199, "TCP", "1-65535", "0.0.0.0/0", "", "DROP"),
+					},
+				},
+				routes: localRoutes,
+			},
+			destination:       db,
+			port:              3306,
+			expectedBlockedBy: pathComponentDestinationNetworkACLReturn,
+			expectedSteps:     6,
+		},
+		{
+			name: "source Network ACL only allows part of the ephemeral ports back",
+			loader: &testPathAnalysisLoader{
+				accessControlGroupRuleList: map[string][]*vserver.AccessControlGroupRule{
+					"acg-db": {testAccessControlGroupRule("INBND", "TCP", "3306", "0.0.0.0/0", "")},
+				},
+				networkACLRuleList: map[string][]*vpc.NetworkAclRule{
+					"nacl-app": {testNetworkACLRule("INBND", 10, "TCP", "1024-40000", "10.0.2.0/24", "", "ALLOW")},
+				},
+				routes: localRoutes,
+			},
+			destination:       db,
+			port:              3306,
+			expectedBlockedBy: pathComponentSourceNetworkACLReturn,
+			expectedSteps:     7,
+		},
+		{
+			name: "ephemeral ports allowed back through both Network ACLs",
+			loader: &testPathAnalysisLoader{
+				accessControlGroupRuleList: map[string][]*vserver.AccessControlGroupRule{
+					"acg-db": {testAccessControlGroupRule("INBND", "TCP", "3306", "0.0.0.0/0", "")},
+				},
+				networkACLRuleList: map[string][]*vpc.NetworkAclRule{
+					"nacl-app": {testNetworkACLRule("INBND", 10, "TCP", "32768-65535", "10.0.2.0/24", "", "ALLOW")},
+					"nacl-db":  {testNetworkACLRule("OTBND", 10, "TCP", "1-65535", "10.0.1.0/24", "", "ALLOW")},
+				},
+				routes: localRoutes,
+			},
+			destination:   db,
+			port:          3306,
+			expectedSteps: 7,
+		},
+		{
+			name: "no destination ACG rule for the port",
+			loader: &testPathAnalysisLoader{
+				accessControlGroupRuleList: map[string][]*vserver.AccessControlGroupRule{
+					"acg-db": {testAccessControlGroupRule("INBND", "TCP", "3306", "10.0.1.0/24", "")},
+				},
+				routes: localRoutes,
+			},
+			destination:       db,
+			port:              5432,
+			expectedBlockedBy: pathComponentDestinationAccessControlGroup,
+			expectedSteps:     5,
+		},
+		{
+			name: "source ACG outbound rules don't cover the destination",
+			loader: &testPathAnalysisLoader{
+				accessControlGroupRuleList: map[string][]*vserver.AccessControlGroupRule{
+					"acg-app": {testAccessControlGroupRule("OTBND", "TCP", "1-65535", "192.168.0.0/16", "")},
+				},
+				routes: localRoutes,
+			},
+			destination:       db,
+			port:              3306,
+			expectedBlockedBy: pathComponentSourceAccessControlGroup,
+			expectedSteps:     1,
+		},
+		{
+			name: "destination Network ACL drops the source before allowing all",
+			loader: &testPathAnalysisLoader{
+				accessControlGroupRuleList: map[string][]*vserver.AccessControlGroupRule{
+					"acg-db": {testAccessControlGroupRule("INBND", "TCP", "3306", "0.0.0.0/0", "")},
+				},
+				networkACLRuleList: map[string][]*vpc.NetworkAclRule{
+					"nacl-db": {
+						testNetworkACLRule("INBND", 20, "TCP", "1-65535", "0.0.0.0/0", "", "ALLOW"),
+						testNetworkACLRule("INBND", 10, "TCP", "3306", "10.0.1.0/24", "", "DROP"),
+					},
+				},
+				routes: localRoutes,
+			},
+			destination:       db,
+			port:              3306,
+			expectedBlockedBy: pathComponentDestinationNetworkACL,
+			expectedSteps:     4,
+		},
+		{
+			name: "source Network ACL drops a deny-allow group",
+			loader: &testPathAnalysisLoader{
+				networkACLRuleList: map[string][]*vpc.NetworkAclRule{
+					"nacl-app": {testNetworkACLRule("OTBND", 1, "TCP", "443", "", "dag-1", "DROP")},
+				},
+				denyAllowGroups: map[string][]string{
+					"dag-1": {"198.51.100.7", "203.0.113.0/24"},
+				},
+				routes: append(localRoutes, testRoute("0.0.0.0/0", "NATGW", "natgw-1")),
+			},
+			destination:       internet,
+			port:              443,
+			expectedBlockedBy: pathComponentSourceNetworkACL,
+			expectedSteps:     2,
+		},
+		{
+			name: "no route to the internet",
+			loader: &testPathAnalysisLoader{
+				routes: localRoutes,
+			},
+			destination:       internet,
+			port:              443,
+			expectedBlockedBy: pathComponentRouteTable,
+			expectedSteps:     3,
+		},
+		{
+			name: "default route to a PRIVATE NAT Gateway",
+			loader: &testPathAnalysisLoader{
+				routes: append(localRoutes, testRoute("0.0.0.0/0", "NATGW", "natgw-1")),
+				natGateways: map[string]*vpc.NatGatewayInstance{
+					"natgw-1": {NatGatewayType: &vpc.CommonCode{Code: ncloud.String("PRIVATE")}},
+				},
+			},
+			destination:       internet,
+			port:              443,
+			expectedBlockedBy: pathComponentRouteTable,
+			expectedSteps:     3,
+		},
+		{
+			name: "default route to a PUBLIC NAT Gateway",
+			loader: &testPathAnalysisLoader{
+				routes: append(localRoutes, testRoute("0.0.0.0/0", "NATGW", "natgw-1")),
+				natGateways: map[string]*vpc.NatGatewayInstance{
+					"natgw-1": {NatGatewayType: &vpc.CommonCode{Code: ncloud.String("PUBLIC")}},
+				},
+			},
+			destination:   internet,
+			port:          443,
+			expectedSteps: 4,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			steps, err := analyzePath(tc.loader, app, tc.destination, "TCP", tc.port)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(steps) != tc.expectedSteps {
+				t.Fatalf("expected %d steps, got %d: %+v", tc.expectedSteps, len(steps), steps)
+			}
+
+			var blockedBy string
+			for _, s := range steps {
+				if s.verdict == pathVerdictDeny {
+					blockedBy = s.component
+				}
+			}
+
+			if blockedBy != tc.expectedBlockedBy {
+				t.Fatalf("expected traffic to be blocked by %q, got %q: %+v", tc.expectedBlockedBy, blockedBy, steps)
+			}
+		})
+	}
+}
+
+func TestMatchPathPortRange(t *testing.T) {
+	cases := []struct {
+		portRange string
+		port      int
+		expected  bool
+	}{
+		{"22", 22, true},
+		{"22", 23, false},
+		{"1-65535", 443, true},
+		{"8000-8080", 8081, false},
+		{"", 0, true},
+	}
+
+	for _, tc := range cases {
+		if got := matchPathPortRange(tc.portRange, tc.port); got != tc.expected {
+			t.Errorf("matchPathPortRange(%q, %d) = %t, expected %t", tc.portRange, tc.port, got, tc.expected)
+		}
+	}
+}
@@ -40,6 +40,12 @@ func ResourceNcloudPlacementGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"server_instance_no_list": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Server instances in the placement group.",
+			},
 		},
 	}
 }
@@ -94,6 +100,12 @@ func resourceNcloudPlacementGroupRead(d *schema.ResourceData, meta interface{})
 	d.Set("placement_group_type", instance.PlacementGroupType.Code)
 	d.Set("name", instance.PlacementGroupName)
 
+	serverInstanceNoList, err := getPlacementGroupServerInstanceNoList(config, d.Id())
+	if err != nil {
+		return err
+	}
+	d.Set("server_instance_no_list", serverInstanceNoList)
+
 	return nil
 }
 
@@ -149,3 +161,61 @@ func validPlacementGroupResponse(placementGroup *vserver.PlacementGroup) bool {
 	}
 	return true
 }
+
+func getPlacementGroupServerInstanceNoList(config *conn.ProviderConfig, placementGroupNo string) ([]string, error) {
+	reqParams := &vserver.GetServerInstanceListRequest{
+		RegionCode:           &config.RegionCode,
+		PlacementGroupNoList: []*string{ncloud.String(placementGroupNo)},
+	}
+
+	LogCommonRequest("getPlacementGroupServerInstanceList", reqParams)
+	resp, err := config.Client.Vserver.V2Api.GetServerInstanceList(reqParams)
+	if err != nil {
+		LogErrorResponse("getPlacementGroupServerInstanceList", err, reqParams)
+		return nil, err
+	}
+	LogResponse("getPlacementGroupServerInstanceList", resp)
+
+	serverInstanceNoList := make([]string, 0, len(resp.ServerInstanceList))
+	for _, r := range resp.ServerInstanceList {
+		serverInstanceNoList = append(serverInstanceNoList, ncloud.StringValue(r.ServerInstanceNo))
+	}
+
+	return serverInstanceNoList, nil
+}
+
+func addPlacementGroupServerInstance(config *conn.ProviderConfig, placementGroupNo, serverInstanceNo string) error {
+	reqParams := &vserver.AddPlacementGroupServerInstanceRequest{
+		RegionCode:       &config.RegionCode,
+		PlacementGroupNo: ncloud.String(placementGroupNo),
+		ServerInstanceNo: ncloud.String(serverInstanceNo),
+	}
+
+	LogCommonRequest("AddPlacementGroupServerInstance", reqParams)
+	resp, err := config.Client.Vserver.V2Api.AddPlacementGroupServerInstance(reqParams)
+	if err != nil {
+		LogErrorResponse("AddPlacementGroupServerInstance", err, reqParams)
+		return err
+	}
+	LogResponse("AddPlacementGroupServerInstance", resp)
+
+	return nil
+}
+
+func removePlacementGroupServerInstance(config *conn.ProviderConfig, placementGroupNo, serverInstanceNo string) error {
+	reqParams := &vserver.RemovePlacementGroupServerInstanceRequest{
+		RegionCode:       &config.RegionCode,
+		PlacementGroupNo: ncloud.String(placementGroupNo),
+		ServerInstanceNo: ncloud.String(serverInstanceNo),
+	}
+
+	LogCommonRequest("RemovePlacementGroupServerInstance", reqParams)
+	resp, err := config.Client.Vserver.V2Api.RemovePlacementGroupServerInstance(reqParams)
+	if err != nil {
+		LogErrorResponse("RemovePlacementGroupServerInstance", err, reqParams)
+		return err
+	}
+	LogResponse("RemovePlacementGroupServerInstance", resp)
+
+	return nil
+}
@@ -48,6 +48,12 @@ func dataSourceNcloudPlacementGroupRead(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
+	serverInstanceNoList, err := getPlacementGroupServerInstanceNoList(config, resources[0]["placement_group_no"].(string))
+	if err != nil {
+		return err
+	}
+	resources[0]["server_instance_no_list"] = serverInstanceNoList
+
 	SetSingularResourceDataFromMap(d, resources[0])
 
 	return nil
@@ -0,0 +1,277 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+)
+
+// antiAffinityPlacementGroupServerLimit is the documented number of servers an AntiAffinity (`AA`) placement group can hold.
+// It isn't returned by the API, so the check in ModifyPlan is only advisory.
+const antiAffinityPlacementGroupServerLimit = 10
+
+var (
+	_ resource.Resource                = &placementGroupMembershipResource{}
+	_ resource.ResourceWithConfigure   = &placementGroupMembershipResource{}
+	_ resource.ResourceWithImportState = &placementGroupMembershipResource{}
+	_ resource.ResourceWithModifyPlan  = &placementGroupMembershipResource{}
+)
+
+type placementGroupMembershipResourceModel struct {
+	PlacementGroupNo types.String `tfsdk:"placement_group_no"`
+	ServerInstanceNo types.String `tfsdk:"server_instance_no"`
+	ID               types.String `tfsdk:"id"`
+}
+
+type placementGroupMembershipResource struct {
+	config *conn.ProviderConfig
+}
+
+func NewPlacementGroupMembershipResource() resource.Resource {
+	return &placementGroupMembershipResource{}
+}
+
+func (p *placementGroupMembershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_placement_group_membership"
+}
+
+func (p *placementGroupMembershipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"placement_group_no": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"server_instance_no": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Server instance to put in the placement group. The server is stopped while it is added or removed, then started again if it was running.",
+			},
+			"id": framework.IDAttribute(),
+		},
+	}
+}
+
+func (p *placementGroupMembershipResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	p.config = config
+}
+
+func (p *placementGroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	placementGroupNo, serverInstanceNo, err := parsePlacementGroupMembershipID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("placement_group_no"), placementGroupNo)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_instance_no"), serverInstanceNo)...)
+}
+
+// ModifyPlan warns when the planned membership would put an AntiAffinity group over its server limit.
+// Only servers already in the group are counted, not other memberships planned in the same run.
+func (p *placementGroupMembershipResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || p.config == nil {
+		return
+	}
+
+	var plan placementGroupMembershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.PlacementGroupNo.IsUnknown() || !req.State.Raw.IsNull() {
+		return
+	}
+
+	placementGroupNo := plan.PlacementGroupNo.ValueString()
+	placementGroup, err := GetPlacementGroupInstance(p.config, placementGroupNo)
+	if err != nil {
+		resp.Diagnostics.AddError("GetPlacementGroupInstance", err.Error())
+		return
+	}
+
+	if placementGroup == nil || ncloud.StringValue(placementGroup.PlacementGroupType.Code) != "AA" {
+		return
+	}
+
+	serverInstanceNoList, err := getPlacementGroupServerInstanceNoList(p.config, placementGroupNo)
+	if err != nil {
+		resp.Diagnostics.AddError("GetPlacementGroupServerInstanceList", err.Error())
+		return
+	}
+
+	count := len(serverInstanceNoList)
+	for _, no := range serverInstanceNoList {
+		if no == plan.ServerInstanceNo.ValueString() {
+			return
+		}
+	}
+
+	if count+1 > antiAffinityPlacementGroupServerLimit {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("placement_group_no"),
+			"AntiAffinity placement group is full",
+			fmt.Sprintf("Placement group %s already has %d servers. An AntiAffinity placement group can hold up to %d servers, so adding another one is expected to fail.",
+				placementGroupNo, count, antiAffinityPlacementGroupServerLimit),
+		)
+	}
+}
+
+func (p *placementGroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan placementGroupMembershipResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	placementGroupNo := plan.PlacementGroupNo.ValueString()
+	serverInstanceNo := plan.ServerInstanceNo.ValueString()
+
+	instance, err := GetServerInstance(p.config, serverInstanceNo)
+	if err != nil {
+		resp.Diagnostics.AddError("GetServerInstance", err.Error())
+		return
+	}
+
+	if instance == nil {
+		resp.Diagnostics.AddError("Error Creating PlacementGroupMembership", fmt.Sprintf("no matching Server instance: %s", serverInstanceNo))
+		return
+	}
+
+	if current := ncloud.StringValue(instance.PlacementGroupNo); len(current) > 0 && current != placementGroupNo {
+		resp.Diagnostics.AddError(
+			"Error Creating PlacementGroupMembership",
+			fmt.Sprintf("Server instance %s is already in placement group %s", serverInstanceNo, current),
+		)
+		return
+	}
+
+	if ncloud.StringValue(instance.PlacementGroupNo) != placementGroupNo {
+		tflog.Info(ctx, "AddPlacementGroupServerInstance", map[string]any{
+			"placementGroupNo": common.MarshalUncheckedString(placementGroupNo),
+			"serverInstanceNo": common.MarshalUncheckedString(serverInstanceNo),
+		})
+
+		err = stopServerInstanceDuring(p.config, serverInstanceNo, "placement_group_no", func() error {
+			return addPlacementGroupServerInstance(p.config, placementGroupNo, serverInstanceNo)
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error Creating PlacementGroupMembership", err.Error())
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(placementGroupMembershipID(placementGroupNo, serverInstanceNo))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (p *placementGroupMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state placementGroupMembershipResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := GetServerInstance(p.config, state.ServerInstanceNo.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("GetServerInstance", err.Error())
+		return
+	}
+
+	if instance == nil || ncloud.StringValue(instance.PlacementGroupNo) != state.PlacementGroupNo.ValueString() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(placementGroupMembershipID(state.PlacementGroupNo.ValueString(), state.ServerInstanceNo.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called: every attribute requires replacement.
+func (p *placementGroupMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+}
+
+func (p *placementGroupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state placementGroupMembershipResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	placementGroupNo := state.PlacementGroupNo.ValueString()
+	serverInstanceNo := state.ServerInstanceNo.ValueString()
+
+	instance, err := GetServerInstance(p.config, serverInstanceNo)
+	if err != nil {
+		resp.Diagnostics.AddError("GetServerInstance", err.Error())
+		return
+	}
+
+	// Nothing to remove when the server is gone or was already moved out of the group
+	if instance == nil || ncloud.StringValue(instance.PlacementGroupNo) != placementGroupNo {
+		return
+	}
+
+	tflog.Info(ctx, "RemovePlacementGroupServerInstance", map[string]any{
+		"placementGroupNo": common.MarshalUncheckedString(placementGroupNo),
+		"serverInstanceNo": common.MarshalUncheckedString(serverInstanceNo),
+	})
+
+	err = stopServerInstanceDuring(p.config, serverInstanceNo, "placement_group_no", func() error {
+		return removePlacementGroupServerInstance(p.config, placementGroupNo, serverInstanceNo)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting PlacementGroupMembership", err.Error())
+		return
+	}
+}
+
+func placementGroupMembershipID(placementGroupNo, serverInstanceNo string) string {
+	return fmt.Sprintf("%s:%s", placementGroupNo, serverInstanceNo)
+}
+
+func parsePlacementGroupMembershipID(id string) (string, string, error) {
+	idParts := strings.Split(id, ":")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%q), expected PLACEMENT_GROUP_NO:SERVER_INSTANCE_NO", id)
+	}
+	return idParts[0], idParts[1], nil
+}
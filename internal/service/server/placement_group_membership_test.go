@@ -0,0 +1,118 @@
+package server_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/server"
+)
+
+func TestAccResourceNcloudPlacementGroupMembership_basic(t *testing.T) {
+	resourceName := "ncloud_placement_group_membership.test"
+	placementGroupName := "ncloud_placement_group.test"
+	name := fmt.Sprintf("tf-pl-member-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckPlacementGroupMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudPlacementGroupMembershipConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "placement_group_no", placementGroupName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "server_instance_no", "ncloud_server.test", "id"),
+				),
+			},
+			{
+				// Refresh to pick up the membership on the placement group
+				Config: testAccResourceNcloudPlacementGroupMembershipConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(placementGroupName, "server_instance_no_list.#", "1"),
+					resource.TestCheckResourceAttrPair(placementGroupName, "server_instance_no_list.0", "ncloud_server.test", "id"),
+					resource.TestCheckResourceAttrPair("ncloud_server.test", "placement_group_no", placementGroupName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceNcloudPlacementGroupMembershipConfig(name string) string {
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "loginkey" {
+	key_name = "%[1]s-key"
+}
+
+resource "ncloud_vpc" "test" {
+	name            = "%[1]s"
+	ipv4_cidr_block = "10.5.0.0/16"
+}
+
+resource "ncloud_subnet" "test" {
+	vpc_no         = ncloud_vpc.test.vpc_no
+	name           = "%[1]s"
+	subnet         = "10.5.0.0/24"
+	zone           = "KR-2"
+	network_acl_no = ncloud_vpc.test.default_network_acl_no
+	subnet_type    = "PUBLIC"
+	usage_type     = "GEN"
+}
+
+data "ncloud_server_image_numbers" "server_images" {
+	filter {
+		name   = "name"
+		values = ["ubuntu-22.04-base"]
+	}
+}
+
+resource "ncloud_server" "test" {
+	subnet_no           = ncloud_subnet.test.id
+	name                = "%[1]s"
+	server_image_number = data.ncloud_server_image_numbers.server_images.image_number_list.0.server_image_number
+	server_spec_code    = "s2-g3"
+	login_key_name      = ncloud_login_key.loginkey.key_name
+}
+
+resource "ncloud_placement_group" "test" {
+	name = "%[1]s"
+}
+
+resource "ncloud_placement_group_membership" "test" {
+	placement_group_no = ncloud_placement_group.test.id
+	server_instance_no = ncloud_server.test.id
+}
+`, name)
+}
+
+func testAccCheckPlacementGroupMembershipDestroy(s *terraform.State) error {
+	config := TestAccProvider.Meta().(*conn.ProviderConfig)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ncloud_placement_group_membership" {
+			continue
+		}
+
+		instance, err := server.GetServerInstance(config, rs.Primary.Attributes["server_instance_no"])
+		if err != nil {
+			return err
+		}
+
+		if instance != nil && ncloud.StringValue(instance.PlacementGroupNo) == rs.Primary.Attributes["placement_group_no"] {
+			return fmt.Errorf("Server instance %s is still in placement group %s", rs.Primary.Attributes["server_instance_no"], rs.Primary.Attributes["placement_group_no"])
+		}
+	}
+
+	return nil
+}
@@ -26,9 +26,11 @@ func ResourceNcloudPublicIpInstance() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 		Schema: map[string]*schema.Schema{
+			// Computed, so that an association made by ncloud_public_ip_association is not undone when this is omitted
 			"server_instance_no": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
 			"description": {
 				Type:             schema.TypeString,
@@ -143,27 +145,27 @@ func resourceNcloudPublicIpUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	if d.HasChange("server_instance_no") {
 		o, n := d.GetChange("server_instance_no")
-		if len(o.(string)) > 0 {
+
+		// The public IP may already have been moved, e.g. released by a replaced server with `preserve_on_replace`
+		current, err := GetPublicIp(config, d.Id())
+		if err != nil {
+			return err
+		}
+		currentServerInstanceNo := ""
+		if current != nil {
+			currentServerInstanceNo = ncloud.StringValue(current.ServerInstanceNo)
+		}
+
+		if len(o.(string)) > 0 && currentServerInstanceNo == o.(string) {
 			if err := disassociatedPublicIp(config, d.Id()); err != nil {
 				return err
 			}
 		}
 
-		if len(n.(string)) > 0 {
-			if err := resource.Retry(time.Minute, func() *resource.RetryError {
-				if err := associatedPublicIp(d, config); err != nil {
-					errBody, _ := GetCommonErrorBody(err)
-					if errBody.ReturnCode == "1003016" {
-						time.Sleep(time.Second * 1)
-						return resource.RetryableError(err)
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			}); err != nil {
+		if len(n.(string)) > 0 && currentServerInstanceNo != n.(string) {
+			if err := associatedPublicIpWithRetry(config, d.Id(), n.(string)); err != nil {
 				return err
 			}
-
 		}
 	}
 
@@ -369,31 +371,46 @@ func waitForPublicIpAssociation(config *conn.ProviderConfig, id string) error {
 	return nil
 }
 
-func associatedPublicIp(d *schema.ResourceData, config *conn.ProviderConfig) error {
-	err := associatedVpcPublicIp(d, config)
+// associatedPublicIpWithRetry retries while the previous disassociation of the server is still in progress
+func associatedPublicIpWithRetry(config *conn.ProviderConfig, id string, serverInstanceNo string) error {
+	return resource.Retry(time.Minute, func() *resource.RetryError {
+		if err := associatedPublicIp(config, id, serverInstanceNo); err != nil {
+			errBody, _ := GetCommonErrorBody(err)
+			if errBody.ReturnCode == "1003016" {
+				time.Sleep(time.Second * 1)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
+func associatedPublicIp(config *conn.ProviderConfig, id string, serverInstanceNo string) error {
+	err := associatedVpcPublicIp(config, id, serverInstanceNo)
 	if err != nil {
 		return err
 	}
 
-	if err := waitForPublicIpAssociation(config, d.Id()); err != nil {
+	if err := waitForPublicIpAssociation(config, id); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func associatedVpcPublicIp(d *schema.ResourceData, config *conn.ProviderConfig) error {
+func associatedVpcPublicIp(config *conn.ProviderConfig, id string, serverInstanceNo string) error {
 	reqParams := &vserver.AssociatePublicIpWithServerInstanceRequest{
 		RegionCode:         &config.RegionCode,
-		PublicIpInstanceNo: ncloud.String(d.Id()),
-		ServerInstanceNo:   ncloud.String(d.Get("server_instance_no").(string)),
+		PublicIpInstanceNo: ncloud.String(id),
+		ServerInstanceNo:   ncloud.String(serverInstanceNo),
 	}
 
 	LogCommonRequest("associatedVpcPublicIp", reqParams)
 
 	resp, err := config.Client.Vserver.V2Api.AssociatePublicIpWithServerInstance(reqParams)
 	if err != nil {
-		LogErrorResponse("associatedVpcPublicIp", err, d.Id())
+		LogErrorResponse("associatedVpcPublicIp", err, id)
 		return err
 	}
 	LogCommonResponse("associatedVpcPublicIp", GetCommonResponse(resp))
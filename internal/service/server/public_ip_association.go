@@ -0,0 +1,129 @@
+package server
+
+import (
+	"log"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+func ResourceNcloudPublicIpAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNcloudPublicIpAssociationCreate,
+		Read:   resourceNcloudPublicIpAssociationRead,
+		Update: resourceNcloudPublicIpAssociationUpdate,
+		Delete: resourceNcloudPublicIpAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"public_ip_no": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"server_instance_no": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"public_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"private_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNcloudPublicIpAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+	publicIpNo := d.Get("public_ip_no").(string)
+
+	if err := reassociatePublicIp(config, publicIpNo, d.Get("server_instance_no").(string)); err != nil {
+		return err
+	}
+
+	d.SetId(publicIpNo)
+	log.Printf("[INFO] Public IP Association ID: %s", d.Id())
+
+	return resourceNcloudPublicIpAssociationRead(d, meta)
+}
+
+func resourceNcloudPublicIpAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+
+	instance, err := GetPublicIp(config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if instance == nil || len(ncloud.StringValue(instance.ServerInstanceNo)) == 0 {
+		log.Printf("[WARN] Public IP Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("public_ip_no", instance.PublicIpInstanceNo)
+	d.Set("server_instance_no", instance.ServerInstanceNo)
+	d.Set("public_ip", instance.PublicIp)
+	d.Set("private_ip", instance.PrivateIp)
+
+	return nil
+}
+
+func resourceNcloudPublicIpAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+
+	if d.HasChange("server_instance_no") {
+		if err := reassociatePublicIp(config, d.Id(), d.Get("server_instance_no").(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceNcloudPublicIpAssociationRead(d, meta)
+}
+
+func resourceNcloudPublicIpAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+
+	instance, err := GetPublicIp(config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Leave the public IP alone when it was already moved to another server outside of this resource
+	if instance == nil || ncloud.StringValue(instance.ServerInstanceNo) != d.Get("server_instance_no").(string) {
+		return nil
+	}
+
+	return disassociatedPublicIp(config, d.Id())
+}
+
+// reassociatePublicIp disassociates the public IP from its current server, if any, then associates it with serverInstanceNo
+func reassociatePublicIp(config *conn.ProviderConfig, publicIpNo string, serverInstanceNo string) error {
+	instance, err := GetPublicIp(config, publicIpNo)
+	if err != nil {
+		return err
+	}
+
+	if instance != nil {
+		current := ncloud.StringValue(instance.ServerInstanceNo)
+		if current == serverInstanceNo {
+			return nil
+		}
+
+		if len(current) > 0 {
+			log.Printf("[INFO] Disassociating Public IP %s from server instance %s", publicIpNo, current)
+			if err := disassociatedPublicIp(config, publicIpNo); err != nil {
+				return err
+			}
+		}
+	}
+
+	return associatedPublicIpWithRetry(config, publicIpNo, serverInstanceNo)
+}
@@ -0,0 +1,144 @@
+package server_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/server"
+)
+
+func TestAccResourceNcloudPublicIpAssociation_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-pip-assoc-%s", acctest.RandString(5))
+	resourceName := "ncloud_public_ip_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckPublicIpAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPublicIpAssociationConfig(name, "blue"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPublicIpAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "public_ip_no", "ncloud_public_ip.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "server_instance_no", "ncloud_server.blue", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "public_ip", "ncloud_public_ip.test", "public_ip"),
+				),
+			},
+			{
+				// Blue/green cutover moves the public IP without destroying it
+				Config: testAccPublicIpAssociationConfig(name, "green"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPublicIpAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "server_instance_no", "ncloud_server.green", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPublicIpAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no Public IP Association id is set")
+		}
+
+		config := TestAccProvider.Meta().(*conn.ProviderConfig)
+		instance, err := server.GetPublicIp(config, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if instance == nil || ncloud.StringValue(instance.ServerInstanceNo) != rs.Primary.Attributes["server_instance_no"] {
+			return fmt.Errorf("public IP %s is not associated with server instance %s", rs.Primary.ID, rs.Primary.Attributes["server_instance_no"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPublicIpAssociationDestroy(s *terraform.State) error {
+	config := TestAccProvider.Meta().(*conn.ProviderConfig)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ncloud_public_ip_association" {
+			continue
+		}
+
+		instance, err := server.GetPublicIp(config, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if instance != nil && len(ncloud.StringValue(instance.ServerInstanceNo)) > 0 {
+			return fmt.Errorf("public IP %s is still associated", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccPublicIpAssociationConfig(name, target string) string {
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "loginkey" {
+	key_name = "%[1]s-key"
+}
+
+resource "ncloud_vpc" "test" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.5.0.0/16"
+}
+
+resource "ncloud_subnet" "test" {
+	vpc_no             = ncloud_vpc.test.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.5.0.0/24"
+	zone               = "KR-2"
+	network_acl_no     = ncloud_vpc.test.default_network_acl_no
+	subnet_type        = "PUBLIC"
+	usage_type         = "GEN"
+}
+
+resource "ncloud_server" "blue" {
+	subnet_no = ncloud_subnet.test.id
+	name = "%[1]s-blue"
+	server_image_product_code = "SW.VSVR.OS.LNX64.ROCKY.0810.B050"
+	server_product_code = "SVR.VSVR.STAND.C002.M008.NET.HDD.B050.G002"
+	login_key_name = ncloud_login_key.loginkey.key_name
+}
+
+resource "ncloud_server" "green" {
+	subnet_no = ncloud_subnet.test.id
+	name = "%[1]s-green"
+	server_image_product_code = "SW.VSVR.OS.LNX64.ROCKY.0810.B050"
+	server_product_code = "SVR.VSVR.STAND.C002.M008.NET.HDD.B050.G002"
+	login_key_name = ncloud_login_key.loginkey.key_name
+}
+
+resource "ncloud_public_ip" "test" {
+	description = "%[1]s"
+}
+
+resource "ncloud_public_ip_association" "test" {
+	public_ip_no       = ncloud_public_ip.test.id
+	server_instance_no = ncloud_server.%[2]s.id
+}
+`, name, target)
+}
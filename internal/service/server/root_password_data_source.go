@@ -1,6 +1,11 @@
 package server
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,9 +24,16 @@ func DataSourceNcloudRootPassword() *schema.Resource {
 				Required: true,
 			},
 			"private_key": {
-				Type:      schema.TypeString,
-				Required:  true,
-				Sensitive: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"private_key", "private_key_file"},
+			},
+			"private_key_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"private_key", "private_key_file"},
+				Description:  "Path to the login key file. A leading `~` is expanded to the home directory. The key is read by the provider and sent to the API, but not stored in state.",
 			},
 			"root_password": {
 				Type:      schema.TypeString,
@@ -35,7 +47,12 @@ func DataSourceNcloudRootPassword() *schema.Resource {
 func dataSourceNcloudRootPasswordRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*conn.ProviderConfig)
 
-	rootPassword, err := getRootPassword(d, config)
+	privateKey, err := getRootPasswordPrivateKey(d)
+	if err != nil {
+		return err
+	}
+
+	rootPassword, err := getRootPassword(config, d.Get("server_instance_no").(string), privateKey)
 	if err != nil {
 		return err
 	}
@@ -46,11 +63,42 @@ func dataSourceNcloudRootPasswordRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func getRootPassword(d *schema.ResourceData, config *conn.ProviderConfig) (*string, error) {
+func getRootPasswordPrivateKey(d *schema.ResourceData) (string, error) {
+	if v, ok := d.GetOk("private_key_file"); ok {
+		path, err := expandHomeDir(v.(string))
+		if err != nil {
+			return "", fmt.Errorf("error reading private_key_file: %s", err)
+		}
+
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("error reading private_key_file: %s", err)
+		}
+		return string(b), nil
+	}
+
+	return d.Get("private_key").(string), nil
+}
+
+// expandHomeDir replaces a leading `~` of path with the home directory of the user running Terraform.
+func expandHomeDir(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, path[1:]), nil
+}
+
+func getRootPassword(config *conn.ProviderConfig, serverInstanceNo string, privateKey string) (*string, error) {
 	reqParams := &vserver.GetRootPasswordRequest{
 		RegionCode:       &config.RegionCode,
-		ServerInstanceNo: ncloud.String(d.Get("server_instance_no").(string)),
-		PrivateKey:       ncloud.String(d.Get("private_key").(string)),
+		ServerInstanceNo: ncloud.String(serverInstanceNo),
+		PrivateKey:       ncloud.String(privateKey),
 	}
 
 	LogCommonRequest("getVpcRootPassword", reqParams)
@@ -0,0 +1,33 @@
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestExpandHomeDir(t *testing.T) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		t.Skipf("no home directory: %s", err)
+	}
+
+	cases := map[string]string{
+		"~/.ssh/key.pem":      filepath.Join(home, ".ssh/key.pem"),
+		"~":                   home,
+		"/tmp/key.pem":        "/tmp/key.pem",
+		"key.pem":             "key.pem",
+		"~other/.ssh/key.pem": "~other/.ssh/key.pem",
+	}
+
+	for input, expected := range cases {
+		got, err := expandHomeDir(input)
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %s", input, err)
+		}
+
+		if got != expected {
+			t.Fatalf("expected %q for %q, got %q", expected, input, got)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -35,6 +37,12 @@ func ResourceNcloudServer() *schema.Resource {
 			Create: schema.DefaultTimeout(conn.DefaultCreateTimeout),
 			Delete: schema.DefaultTimeout(conn.DefaultTimeout),
 		},
+		CustomizeDiff: customdiff.All(
+			// The primary network interface (order 0) is bound to the server at creation and can't be detached.
+			customdiff.ForceNewIfChange("network_interface", func(ctx context.Context, old, new, meta any) bool {
+				return primaryNetworkInterfaceNo(old.([]interface{})) != primaryNetworkInterfaceNo(new.([]interface{}))
+			}),
+		),
 		Schema: map[string]*schema.Schema{
 			"server_image_product_code": {
 				Type:          schema.TypeString,
@@ -67,26 +75,29 @@ func ResourceNcloudServer() *schema.Resource {
 				Computed: true,
 			},
 			"name": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Server name to create.",
 				ValidateDiagFunc: validation.ToDiagFunc(validation.All(
 					validation.StringLenBetween(3, 30),
 					validation.StringMatch(regexp.MustCompile(`^[a-z]+[a-z0-9-]+[a-z0-9]$`), "Allows only lowercase letters(a-z), numbers, hyphen (-). Must start with an alphabetic character, must end with an English letter or number"),
 				)),
 			},
 			"description": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Server description to create.",
 			},
 			"login_key_name": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Login key name to encrypt the root password with.",
 			},
 			"is_protect_server_termination": {
 				Type:     schema.TypeBool,
@@ -94,9 +105,10 @@ func ResourceNcloudServer() *schema.Resource {
 				Computed: true,
 			},
 			"fee_system_type_code": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Fee system type code. `MTRAT` | `FXSUM`.",
 			},
 			"zone": {
 				Type:     schema.TypeString,
@@ -109,6 +121,27 @@ func ResourceNcloudServer() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"preserve_on_replace": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"block_storages": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Detach additional block storages and keep them when the server is destroyed or replaced.",
+						},
+						"public_ip": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Disassociate the public IP and keep it when the server is destroyed or replaced.",
+						},
+					},
+				},
+			},
 			"block_device_partition_list": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -135,10 +168,11 @@ func ResourceNcloudServer() *schema.Resource {
 				ForceNew: true,
 			},
 			"init_script_no": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Init script ID run at first boot.",
 			},
 			"placement_group_no": {
 				Type:     schema.TypeString,
@@ -154,12 +188,10 @@ func ResourceNcloudServer() *schema.Resource {
 						"network_interface_no": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 						"order": {
 							Type:     schema.TypeInt,
 							Required: true,
-							ForceNew: true,
 						},
 						"subnet_no": {
 							Type:     schema.TypeString,
@@ -307,12 +339,28 @@ func resourceNcloudServerDelete(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	preserveBlockStorages, preservePublicIp := expandServerPreserveOnReplace(d.Get("preserve_on_replace").([]interface{}))
+
+	if preservePublicIp && len(ncloud.StringValue(serverInstance.PublicIp)) > 0 {
+		publicIp, err := getPublicIpByAddress(config, ncloud.StringValue(serverInstance.PublicIp))
+		if err != nil {
+			return err
+		}
+
+		if publicIp != nil {
+			log.Printf("[INFO] Disassociating Public IP %s from Instance %q to keep it", ncloud.StringValue(publicIp.PublicIpInstanceNo), d.Id())
+			if err := disassociatedPublicIp(config, ncloud.StringValue(publicIp.PublicIpInstanceNo)); err != nil {
+				return err
+			}
+		}
+	}
+
 	blockStorageList, err := getAdditionalBlockStorageList(config, d.Id())
 	if err != nil {
 		return err
 	}
 
-	if len(blockStorageList) > 0 {
+	if preserveBlockStorages && len(blockStorageList) > 0 {
 		for _, blockStorage := range blockStorageList {
 			if err := disconnectBlockStorage(config, blockStorage); err != nil {
 				return err
@@ -335,6 +383,17 @@ func resourceNcloudServerDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+// expandServerPreserveOnReplace returns which attachments to keep when the server is destroyed.
+// Block storages are kept unless turned off, since that was the behavior before `preserve_on_replace`.
+func expandServerPreserveOnReplace(l []interface{}) (blockStorages bool, publicIp bool) {
+	if len(l) == 0 || l[0] == nil {
+		return true, false
+	}
+
+	m := l[0].(map[string]interface{})
+	return m["block_storages"].(bool), m["public_ip"].(bool)
+}
+
 func resourceNcloudServerUpdate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*conn.ProviderConfig)
 
@@ -350,6 +409,18 @@ func resourceNcloudServerUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	if d.HasChange("placement_group_no") {
+		if err := updateServerPlacementGroup(d, config); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("network_interface") {
+		if err := updateServerNetworkInterface(d, config); err != nil {
+			return err
+		}
+	}
+
 	return resourceNcloudServerRead(d, meta)
 }
 
@@ -458,6 +529,8 @@ func createServerInstance(d *schema.ResourceData, config *conn.ProviderConfig) (
 }
 
 func waitStateNcloudServerForCreation(config *conn.ProviderConfig, id string) error {
+	history := &serverStatusHistory{}
+
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{"INIT", "CREAT"},
 		Target:  []string{"RUN"},
@@ -471,6 +544,8 @@ func waitStateNcloudServerForCreation(config *conn.ProviderConfig, id string) er
 				return 0, "", fmt.Errorf("fail to get Server instance, %s doesn't exist", id)
 			}
 
+			history.observe(time.Now(), ncloud.StringValue(instance.ServerInstanceStatus), ncloud.StringValue(instance.ServerInstanceOperation))
+
 			return instance, ncloud.StringValue(instance.ServerInstanceStatus), nil
 		},
 		Timeout:    conn.DefaultCreateTimeout,
@@ -480,7 +555,7 @@ func waitStateNcloudServerForCreation(config *conn.ProviderConfig, id string) er
 
 	_, err := stateConf.WaitForState()
 	if err != nil {
-		return fmt.Errorf("error waiting for ServerInstance state to be \"RUN\": %s", err)
+		return serverWaitError(config, id, history, fmt.Errorf("error waiting for ServerInstance state to be \"RUN\": %w", err))
 	}
 
 	return nil
@@ -592,6 +667,145 @@ func updateServerProtectionTermination(d *schema.ResourceData, config *conn.Prov
 	return nil
 }
 
+func updateServerPlacementGroup(d *schema.ResourceData, config *conn.ProviderConfig) error {
+	o, n := d.GetChange("placement_group_no")
+
+	return stopServerInstanceDuring(config, d.Id(), "placement_group_no", func() error {
+		if len(o.(string)) > 0 {
+			if err := removePlacementGroupServerInstance(config, o.(string), d.Id()); err != nil {
+				return err
+			}
+		}
+
+		if len(n.(string)) > 0 {
+			return addPlacementGroupServerInstance(config, n.(string), d.Id())
+		}
+
+		return nil
+	})
+}
+
+// updateServerNetworkInterface detaches secondary network interfaces which are removed or reordered,
+// then attaches the new ones in ascending order. The device name (eth1, eth2) follows the attach order.
+func updateServerNetworkInterface(d *schema.ResourceData, config *conn.ProviderConfig) error {
+	o, n := d.GetChange("network_interface")
+	oldList := expandServerNetworkInterfaceOrders(o.([]interface{}))
+	newList := expandServerNetworkInterfaceOrders(n.([]interface{}))
+
+	detachList, attachList := diffServerNetworkInterfaces(oldList, newList)
+	if len(detachList) == 0 && len(attachList) == 0 {
+		return nil
+	}
+
+	return stopServerInstanceDuring(config, d.Id(), "network_interface", func() error {
+		for _, no := range detachList {
+			if err := detachServerNetworkInterface(config, d.Id(), no); err != nil {
+				return err
+			}
+		}
+
+		for _, no := range attachList {
+			if err := attachServerNetworkInterface(config, d.Id(), no); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func attachServerNetworkInterface(config *conn.ProviderConfig, serverInstanceNo string, networkInterfaceNo string) error {
+	networkInterface, err := GetNetworkInterface(config, networkInterfaceNo)
+	if err != nil {
+		return err
+	}
+
+	if networkInterface == nil {
+		return fmt.Errorf("no matching network interface [%s] found", networkInterfaceNo)
+	}
+
+	reqParams := &vserver.AttachNetworkInterfaceRequest{
+		RegionCode:         &config.RegionCode,
+		ServerInstanceNo:   ncloud.String(serverInstanceNo),
+		NetworkInterfaceNo: networkInterface.NetworkInterfaceNo,
+		SubnetNo:           networkInterface.SubnetNo,
+	}
+
+	LogCommonRequest("attachServerNetworkInterface", reqParams)
+	resp, err := config.Client.Vserver.V2Api.AttachNetworkInterface(reqParams)
+	if err != nil {
+		LogErrorResponse("attachServerNetworkInterface", err, reqParams)
+		return err
+	}
+	LogResponse("attachServerNetworkInterface", resp)
+
+	return waitForNetworkInterfaceAttachment(config, networkInterfaceNo)
+}
+
+func detachServerNetworkInterface(config *conn.ProviderConfig, serverInstanceNo string, networkInterfaceNo string) error {
+	networkInterface, err := GetNetworkInterface(config, networkInterfaceNo)
+	if err != nil {
+		return err
+	}
+
+	if networkInterface == nil || ncloud.StringValue(networkInterface.InstanceNo) != serverInstanceNo {
+		return nil
+	}
+
+	reqParams := &vserver.DetachNetworkInterfaceRequest{
+		RegionCode:         &config.RegionCode,
+		ServerInstanceNo:   ncloud.String(serverInstanceNo),
+		NetworkInterfaceNo: networkInterface.NetworkInterfaceNo,
+		SubnetNo:           networkInterface.SubnetNo,
+	}
+
+	LogCommonRequest("detachServerNetworkInterface", reqParams)
+	resp, err := config.Client.Vserver.V2Api.DetachNetworkInterface(reqParams)
+	if err != nil {
+		LogErrorResponse("detachServerNetworkInterface", err, reqParams)
+		return err
+	}
+	LogResponse("detachServerNetworkInterface", resp)
+
+	return waitForVpcNetworkInterfaceState(config, networkInterfaceNo, []string{NetworkInterfaceStateUnSet}, []string{NetworkInterfaceStateNotUsed})
+}
+
+// stopServerInstanceDuring stops the server if it is running, runs fn and starts the server again,
+// also when fn fails.
+func stopServerInstanceDuring(config *conn.ProviderConfig, id string, attr string, fn func() error) (err error) {
+	serverInstance, err := GetServerInstance(config, id)
+	if err != nil {
+		return err
+	}
+
+	if serverInstance == nil {
+		return fmt.Errorf("fail to get Server instance, %s doesn't exist", id)
+	}
+
+	wasRunning := ncloud.StringValue(serverInstance.ServerInstanceStatus) != "NSTOP"
+	if wasRunning {
+		log.Printf("[INFO] Stopping Instance %q for %s change", id, attr)
+		if err := stopThenWaitServerInstance(config, id); err != nil {
+			return err
+		}
+	}
+
+	if wasRunning {
+		defer func() {
+			log.Printf("[INFO] Start Instance %q for %s change", id, attr)
+			if startErr := startThenWaitServerInstance(config, id); startErr != nil {
+				if err != nil {
+					log.Printf("[ERROR] Fail to start Instance %q after failed %s change: %s", id, attr, startErr)
+					return
+				}
+				err = startErr
+			}
+		}()
+	}
+
+	return fn()
+}
+
 func startThenWaitServerInstance(config *conn.ProviderConfig, id string) error {
 	var err error
 	err = startVpcServerInstance(config, id)
@@ -725,11 +939,9 @@ func buildNetworkInterfaceList(config *conn.ProviderConfig, r *ServerInstance) e
 			continue
 		}
 
-		re := regexp.MustCompile("[0-9]+")
-		order, err := strconv.Atoi(re.FindString(*networkInterface.DeviceName))
-
+		order, err := parseNetworkInterfaceOrder(*networkInterface.DeviceName)
 		if err != nil {
-			return fmt.Errorf("error parsing network interface device name: %s", *networkInterface.DeviceName)
+			return err
 		}
 
 		ni.PrivateIp = networkInterface.Ip
@@ -741,6 +953,17 @@ func buildNetworkInterfaceList(config *conn.ProviderConfig, r *ServerInstance) e
 	return nil
 }
 
+// parseNetworkInterfaceOrder returns the interface order from its device name, e.g. 1 for `eth1`
+func parseNetworkInterfaceOrder(deviceName string) (int, error) {
+	re := regexp.MustCompile("[0-9]+")
+	order, err := strconv.Atoi(re.FindString(deviceName))
+	if err != nil {
+		return 0, fmt.Errorf("error parsing network interface device name: %s", deviceName)
+	}
+
+	return order, nil
+}
+
 func stopThenWaitServerInstance(config *conn.ProviderConfig, id string) error {
 	var err error
 
@@ -970,6 +1193,8 @@ func convertVpcBlockStorage(storage *vserver.BlockStorageInstance) *BlockStorage
 		DiskType:                common.GetCodePtrByCommonCode(storage.BlockStorageDiskType),
 		DiskDetailType:          common.GetCodePtrByCommonCode(storage.BlockStorageDiskDetailType),
 		ZoneCode:                storage.ZoneCode,
+		MaxIops:                 storage.MaxIopsThroughput,
+		VolumeType:              common.GetCodePtrByCommonCode(storage.BlockStorageVolumeType),
 	}
 }
 
@@ -22,7 +22,11 @@ func DataSourceNcloudServer() *schema.Resource {
 		"filter": DataSourceFiltersSchema(),
 	}
 
-	return GetSingularDataSourceItemSchema(ResourceNcloudServer(), fieldMap, dataSourceNcloudServerRead)
+	for k, v := range serverTopologySchema() {
+		fieldMap[k] = v
+	}
+
+	return GetSingularDataSourceItemSchema(serverDataSourceResourceSchema(), fieldMap, dataSourceNcloudServerRead)
 }
 
 func dataSourceNcloudServerRead(d *schema.ResourceData, meta interface{}) error {
@@ -46,7 +50,23 @@ func dataSourceNcloudServerRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	d.SetId(resources[0]["instance_no"].(string))
+	instanceNo := resources[0]["instance_no"].(string)
+	for _, instance := range instances {
+		if ncloud.StringValue(instance.ServerInstanceNo) != instanceNo {
+			continue
+		}
+
+		topology, err := getServerTopology(config, instance)
+		if err != nil {
+			return err
+		}
+
+		for k, v := range topology {
+			resources[0][k] = v
+		}
+	}
+
+	d.SetId(instanceNo)
 	SetSingularResourceDataFromMapSchema(DataSourceNcloudServer(), d, resources[0])
 	return nil
 }
@@ -78,3 +98,240 @@ func getServerList(d *schema.ResourceData, config *conn.ProviderConfig) ([]*Serv
 
 	return list, nil
 }
+
+// serverTopologySchema describes the volumes, network interfaces and public IP attached to a server,
+// so the whole topology can be read without chaining per-resource data sources.
+func serverTopologySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"block_storages": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"block_storage_no": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"size": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"device_name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"disk_type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"disk_detail_type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"volume_type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"max_iops": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"status": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"network_interfaces": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"network_interface_no": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"order": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"device_name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"subnet_no": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"private_ip": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"secondary_private_ips": {
+						Type:     schema.TypeList,
+						Computed: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"mac_address": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"access_control_groups": {
+						Type:     schema.TypeList,
+						Computed: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"is_default": {
+						Type:     schema.TypeBool,
+						Computed: true,
+					},
+					"status": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"public_ip_instance": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"public_ip_no": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"public_ip": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"description": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"status": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// getServerTopology looks up the block storages, network interfaces and public IP of a server
+// and returns them keyed by the attribute names of serverTopologySchema.
+func getServerTopology(config *conn.ProviderConfig, r *ServerInstance) (map[string]interface{}, error) {
+	instanceNo := ncloud.StringValue(r.ServerInstanceNo)
+
+	blockStorageList, err := getVpcBasicBlockStorageList(config, instanceNo)
+	if err != nil {
+		return nil, err
+	}
+
+	blockStorages := make([]map[string]interface{}, 0, len(blockStorageList))
+	for _, b := range blockStorageList {
+		blockStorages = append(blockStorages, map[string]interface{}{
+			"block_storage_no": ncloud.StringValue(b.BlockStorageInstanceNo),
+			"name":             ncloud.StringValue(b.BlockStorageName),
+			"type":             ncloud.StringValue(b.BlockStorageType),
+			"size":             int(ncloud.Int64Value(b.BlockStorageSize) / GIGABYTE),
+			"device_name":      ncloud.StringValue(b.DeviceName),
+			"disk_type":        ncloud.StringValue(b.DiskType),
+			"disk_detail_type": ncloud.StringValue(b.DiskDetailType),
+			"volume_type":      ncloud.StringValue(b.VolumeType),
+			"max_iops":         int(ncloud.Int32Value(b.MaxIops)),
+			"status":           ncloud.StringValue(b.Status),
+		})
+	}
+
+	networkInterfaces := make([]map[string]interface{}, 0, len(r.NetworkInterfaceList))
+	for _, ni := range r.NetworkInterfaceList {
+		networkInterface, err := GetNetworkInterface(config, ncloud.StringValue(ni.NetworkInterfaceNo))
+		if err != nil {
+			return nil, err
+		}
+
+		if networkInterface == nil {
+			continue
+		}
+
+		order, err := parseNetworkInterfaceOrder(ncloud.StringValue(networkInterface.DeviceName))
+		if err != nil {
+			return nil, err
+		}
+
+		networkInterfaces = append(networkInterfaces, map[string]interface{}{
+			"network_interface_no":  ncloud.StringValue(networkInterface.NetworkInterfaceNo),
+			"name":                  ncloud.StringValue(networkInterface.NetworkInterfaceName),
+			"order":                 order,
+			"device_name":           ncloud.StringValue(networkInterface.DeviceName),
+			"subnet_no":             ncloud.StringValue(networkInterface.SubnetNo),
+			"private_ip":            ncloud.StringValue(networkInterface.Ip),
+			"secondary_private_ips": StringPtrArrToStringArr(networkInterface.SecondaryIpList),
+			"mac_address":           ncloud.StringValue(networkInterface.MacAddress),
+			"access_control_groups": StringPtrArrToStringArr(networkInterface.AccessControlGroupNoList),
+			"is_default":            ncloud.BoolValue(networkInterface.IsDefault),
+			"status":                ncloud.StringValue(GetCodePtrByCommonCode(networkInterface.NetworkInterfaceStatus)),
+		})
+	}
+
+	publicIpInstance := make([]map[string]interface{}, 0, 1)
+	if publicIp := ncloud.StringValue(r.PublicIp); len(publicIp) > 0 {
+		p, err := getPublicIpByAddress(config, publicIp)
+		if err != nil {
+			return nil, err
+		}
+
+		if p != nil {
+			publicIpInstance = append(publicIpInstance, map[string]interface{}{
+				"public_ip_no": ncloud.StringValue(p.PublicIpInstanceNo),
+				"public_ip":    ncloud.StringValue(p.PublicIp),
+				"description":  ncloud.StringValue(p.PublicIpDescription),
+				"status":       ncloud.StringValue(GetCodePtrByCommonCode(p.PublicIpInstanceStatus)),
+			})
+		}
+	}
+
+	return map[string]interface{}{
+		"block_storages":     blockStorages,
+		"network_interfaces": networkInterfaces,
+		"public_ip_instance": publicIpInstance,
+	}, nil
+}
+
+func getPublicIpByAddress(config *conn.ProviderConfig, publicIp string) (*vserver.PublicIpInstance, error) {
+	reqParams := &vserver.GetPublicIpInstanceListRequest{
+		RegionCode: &config.RegionCode,
+		PublicIp:   ncloud.String(publicIp),
+	}
+
+	LogCommonRequest("getVpcPublicIpByAddress", reqParams)
+	resp, err := config.Client.Vserver.V2Api.GetPublicIpInstanceList(reqParams)
+	if err != nil {
+		LogErrorResponse("getVpcPublicIpByAddress", err, reqParams)
+		return nil, err
+	}
+	LogResponse("getVpcPublicIpByAddress", resp)
+
+	for _, p := range resp.PublicIpInstanceList {
+		if ncloud.StringValue(p.PublicIp) == publicIp {
+			return p, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -45,6 +45,16 @@ func TestAccDataSourceNcloudServer_vpc_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(dataName, "vpc_no", resourceName, "vpc_no"),
 					resource.TestCheckResourceAttrPair(dataName, "network_interface.#", resourceName, "network_interface.#"),
 					resource.TestCheckResourceAttrPair(dataName, "network_interface.0.network_interface_no", resourceName, "network_interface.0.network_interface_no"),
+
+					// Topology
+					resource.TestCheckResourceAttr(dataName, "block_storages.#", "1"),
+					resource.TestCheckResourceAttr(dataName, "block_storages.0.type", "BASIC"),
+					resource.TestCheckResourceAttr(dataName, "network_interfaces.#", "1"),
+					resource.TestCheckResourceAttrPair(dataName, "network_interfaces.0.network_interface_no", resourceName, "network_interface.0.network_interface_no"),
+					resource.TestCheckResourceAttrPair(dataName, "network_interfaces.0.private_ip", resourceName, "network_interface.0.private_ip"),
+					resource.TestCheckResourceAttr(dataName, "network_interfaces.0.order", "0"),
+					resource.TestCheckResourceAttr(dataName, "network_interfaces.0.access_control_groups.#", "1"),
+					resource.TestCheckResourceAttr(dataName, "public_ip_instance.#", "0"),
 					TestAccCheckDataSourceID("data.ncloud_server.by_filter"),
 				),
 			},
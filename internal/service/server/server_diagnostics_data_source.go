@@ -0,0 +1,238 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+var (
+	_ datasource.DataSource              = &serverDiagnosticsDataSource{}
+	_ datasource.DataSourceWithConfigure = &serverDiagnosticsDataSource{}
+)
+
+func NewServerDiagnosticsDataSource() datasource.DataSource {
+	return &serverDiagnosticsDataSource{}
+}
+
+type serverDiagnosticsDataSource struct {
+	config *conn.ProviderConfig
+}
+
+func (d *serverDiagnosticsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_diagnostics"
+}
+
+func (d *serverDiagnosticsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"server_instance_no": schema.StringAttribute{
+				Required: true,
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+			"status_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"operation": schema.StringAttribute{
+				Computed:    true,
+				Description: "Operation in progress on the server, e.g. `START`, `SHTDN` or `NULL` when idle.",
+			},
+			"hypervisor_type": schema.StringAttribute{
+				Computed: true,
+			},
+			"platform_type": schema.StringAttribute{
+				Computed: true,
+			},
+			"init_script_no": schema.StringAttribute{
+				Computed: true,
+			},
+			"create_date": schema.StringAttribute{
+				Computed: true,
+			},
+			"uptime": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *serverDiagnosticsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.config = config
+}
+
+func (d *serverDiagnosticsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data serverDiagnosticsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "GetServerDiagnostics", map[string]any{
+		"serverInstanceNo": common.MarshalUncheckedString(data.ServerInstanceNo.ValueString()),
+	})
+
+	output, err := getServerDiagnostics(d.config, data.ServerInstanceNo.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.Diagnostics.AddError("READING ERROR", fmt.Sprintf("no matching Server instance: %s", data.ServerInstanceNo.ValueString()))
+		return
+	}
+
+	data.refreshFromOutput(output)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+type serverDiagnosticsDataSourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	ServerInstanceNo types.String `tfsdk:"server_instance_no"`
+	Status           types.String `tfsdk:"status"`
+	StatusName       types.String `tfsdk:"status_name"`
+	Operation        types.String `tfsdk:"operation"`
+	HypervisorType   types.String `tfsdk:"hypervisor_type"`
+	PlatformType     types.String `tfsdk:"platform_type"`
+	InitScriptNo     types.String `tfsdk:"init_script_no"`
+	CreateDate       types.String `tfsdk:"create_date"`
+	Uptime           types.String `tfsdk:"uptime"`
+}
+
+func (m *serverDiagnosticsDataSourceModel) refreshFromOutput(output *serverDiagnostics) {
+	m.ID = types.StringValue(output.ServerInstanceNo)
+	m.ServerInstanceNo = types.StringValue(output.ServerInstanceNo)
+	m.Status = types.StringValue(output.Status)
+	m.StatusName = types.StringValue(output.StatusName)
+	m.Operation = types.StringValue(output.Operation)
+	m.HypervisorType = types.StringValue(output.HypervisorType)
+	m.PlatformType = types.StringValue(output.PlatformType)
+	m.InitScriptNo = types.StringValue(output.InitScriptNo)
+	m.CreateDate = types.StringValue(output.CreateDate)
+	m.Uptime = types.StringValue(output.Uptime)
+}
+
+// serverDiagnostics is a snapshot of what the vserver API tells about a server, used to explain failing boots.
+type serverDiagnostics struct {
+	ServerInstanceNo string
+	Status           string
+	StatusName       string
+	Operation        string
+	HypervisorType   string
+	PlatformType     string
+	InitScriptNo     string
+	CreateDate       string
+	Uptime           string
+}
+
+func (s *serverDiagnostics) String() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "server instance %s: status=%s (%s), operation=%s, hypervisor=%s, platform=%s",
+		s.ServerInstanceNo, s.Status, s.StatusName, s.Operation, s.HypervisorType, s.PlatformType)
+	if len(s.InitScriptNo) > 0 {
+		fmt.Fprintf(&b, ", init_script_no=%s", s.InitScriptNo)
+	}
+	fmt.Fprintf(&b, ", created=%s", s.CreateDate)
+
+	return b.String()
+}
+
+func getServerDiagnostics(config *conn.ProviderConfig, id string) (*serverDiagnostics, error) {
+	reqParams := &vserver.GetServerInstanceDetailRequest{
+		RegionCode:       &config.RegionCode,
+		ServerInstanceNo: ncloud.String(id),
+	}
+
+	common.LogCommonRequest("getServerDiagnostics", reqParams)
+	resp, err := config.Client.Vserver.V2Api.GetServerInstanceDetail(reqParams)
+	if err != nil {
+		common.LogErrorResponse("getServerDiagnostics", err, reqParams)
+		return nil, err
+	}
+	common.LogResponse("getServerDiagnostics", resp)
+
+	if resp == nil || len(resp.ServerInstanceList) == 0 {
+		return nil, nil
+	}
+
+	r := resp.ServerInstanceList[0]
+	return &serverDiagnostics{
+		ServerInstanceNo: ncloud.StringValue(r.ServerInstanceNo),
+		Status:           ncloud.StringValue(common.GetCodePtrByCommonCode(r.ServerInstanceStatus)),
+		StatusName:       ncloud.StringValue(r.ServerInstanceStatusName),
+		Operation:        ncloud.StringValue(common.GetCodePtrByCommonCode(r.ServerInstanceOperation)),
+		HypervisorType:   ncloud.StringValue(common.GetCodePtrByCommonCode(r.HypervisorType)),
+		PlatformType:     ncloud.StringValue(common.GetCodePtrByCommonCode(r.PlatformType)),
+		InitScriptNo:     ncloud.StringValue(r.InitScriptNo),
+		CreateDate:       ncloud.StringValue(r.CreateDate),
+		Uptime:           ncloud.StringValue(r.Uptime),
+	}, nil
+}
+
+// serverStatusHistory records the status and operation transitions observed while waiting on a server.
+type serverStatusHistory struct {
+	entries []string
+	last    string
+}
+
+func (h *serverStatusHistory) observe(at time.Time, status, operation string) {
+	current := fmt.Sprintf("%s/%s", status, operation)
+	if current == h.last {
+		return
+	}
+
+	h.last = current
+	h.entries = append(h.entries, fmt.Sprintf("%s %s", at.UTC().Format(time.RFC3339), current))
+}
+
+func (h *serverStatusHistory) String() string {
+	return strings.Join(h.entries, " -> ")
+}
+
+// serverWaitError appends the observed status history and the current diagnostics of a server to a waiter error.
+// The waiter error is wrapped, so callers can still inspect it with errors.As.
+func serverWaitError(config *conn.ProviderConfig, id string, history *serverStatusHistory, err error) error {
+	var details string
+	if len(history.entries) > 0 {
+		details += "\n  status history: " + history.String()
+	}
+
+	if diagnostics, diagErr := getServerDiagnostics(config, id); diagErr == nil && diagnostics != nil {
+		details += "\n  diagnostics: " + diagnostics.String()
+	}
+
+	return fmt.Errorf("%w%s", err, details)
+}
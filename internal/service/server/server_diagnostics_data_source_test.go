@@ -0,0 +1,81 @@
+package server_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+)
+
+func TestAccDataSourceNcloudServerDiagnostics_vpc_basic(t *testing.T) {
+	dataName := "data.ncloud_server_diagnostics.diagnostics"
+	resourceName := "ncloud_server.server"
+	testServerName := GetTestServerName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceServerDiagnosticsVpcConfig(testServerName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataName, "status", "RUN"),
+					resource.TestCheckResourceAttr(dataName, "operation", "NULL"),
+					resource.TestCheckResourceAttr(dataName, "hypervisor_type", "KVM"),
+					resource.TestCheckResourceAttrPair(dataName, "platform_type", resourceName, "platform_type"),
+					resource.TestCheckResourceAttrPair(dataName, "init_script_no", resourceName, "init_script_no"),
+					resource.TestCheckResourceAttrSet(dataName, "create_date"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceServerDiagnosticsVpcConfig(testServerName string) string {
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "loginkey" {
+	key_name = "%[1]s-key"
+}
+
+resource "ncloud_vpc" "test" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.5.0.0/16"
+}
+
+resource "ncloud_subnet" "test" {
+	vpc_no             = ncloud_vpc.test.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.5.0.0/24"
+	zone               = "KR-2"
+	network_acl_no     = ncloud_vpc.test.default_network_acl_no
+	subnet_type        = "PUBLIC"
+	usage_type         = "GEN"
+}
+
+data "ncloud_server_image_numbers" "server_images" {
+	filter {
+		name = "name"
+		values = ["ubuntu-22.04-base"]
+	}
+	filter {
+		name = "hypervisor_type"
+		values = ["KVM"]
+	}
+}
+
+resource "ncloud_server" "server" {
+	subnet_no = ncloud_subnet.test.id
+	name = "%[1]s"
+	server_image_number = data.ncloud_server_image_numbers.server_images.image_number_list.0.server_image_number
+	server_spec_code = "c2-g3"
+	login_key_name = ncloud_login_key.loginkey.key_name
+}
+
+data "ncloud_server_diagnostics" "diagnostics" {
+	server_instance_no = ncloud_server.server.id
+}
+`, testServerName)
+}
@@ -0,0 +1,46 @@
+package server
+
+import (
+	"testing"
+	"time"
+)
+
+func TestServerStatusHistory(t *testing.T) {
+	history := &serverStatusHistory{}
+	start := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	history.observe(start, "INIT", "NULL")
+	history.observe(start.Add(5*time.Second), "INIT", "NULL")
+	history.observe(start.Add(10*time.Second), "CREAT", "NULL")
+	history.observe(start.Add(20*time.Second), "CREAT", "START")
+
+	expected := "2024-01-02T03:04:05Z INIT/NULL -> 2024-01-02T03:04:15Z CREAT/NULL -> 2024-01-02T03:04:25Z CREAT/START"
+	if history.String() != expected {
+		t.Fatalf("expected %q, got %q", expected, history.String())
+	}
+}
+
+func TestServerDiagnosticsString(t *testing.T) {
+	diagnostics := &serverDiagnostics{
+		ServerInstanceNo: "1234",
+		Status:           "CREAT",
+		StatusName:       "creating",
+		Operation:        "NULL",
+		HypervisorType:   "KVM",
+		PlatformType:     "LNX64",
+		InitScriptNo:     "56",
+		CreateDate:       "2024-01-02T03:04:05+0900",
+	}
+
+	expected := "server instance 1234: status=CREAT (creating), operation=NULL, hypervisor=KVM, platform=LNX64, init_script_no=56, created=2024-01-02T03:04:05+0900"
+	if diagnostics.String() != expected {
+		t.Fatalf("expected %q, got %q", expected, diagnostics.String())
+	}
+
+	diagnostics.InitScriptNo = ""
+
+	expected = "server instance 1234: status=CREAT (creating), operation=NULL, hypervisor=KVM, platform=LNX64, created=2024-01-02T03:04:05+0900"
+	if diagnostics.String() != expected {
+		t.Fatalf("expected %q, got %q", expected, diagnostics.String())
+	}
+}
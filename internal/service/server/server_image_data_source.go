@@ -32,7 +32,9 @@ func DataSourceNcloudServerImage() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
-			"filter": DataSourceFiltersSchema(),
+			"filter":      DataSourceFiltersSchema(),
+			"name_regex":  DataSourceNameRegexSchema(),
+			"most_recent": DataSourceMostRecentSchema(),
 
 			"product_name": {
 				Type:     schema.TypeString,
@@ -69,6 +71,13 @@ func dataSourceNcloudServerImageRead(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
+	resources, err = SelectImages(resources, d.Get("name_regex").(string), d.Get("most_recent").(bool), func(m map[string]interface{}) ImageSortKey {
+		return ImageSortKey{Name: m["product_name"].(string)}
+	})
+	if err != nil {
+		return err
+	}
+
 	if err := verify.ValidateOneResult(len(resources)); err != nil {
 		return err
 	}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -50,6 +51,8 @@ func (d *serverImageNumbersDataSource) Schema(_ context.Context, _ datasource.Sc
 					stringvalidator.OneOf([]string{"XEN", "KVM"}...),
 				},
 			},
+			"name_regex":  common.DataSourceNameRegexAttribute(),
+			"most_recent": common.DataSourceMostRecentAttribute(),
 			"output_file": schema.StringAttribute{
 				Optional: true,
 			},
@@ -179,6 +182,13 @@ func (d *serverImageNumbersDataSource) Read(ctx context.Context, req datasource.
 		return
 	}
 	fillteredList := common.FilterModels(ctx, data.Filters, imagesNoList)
+	fillteredList, err = common.SelectImages(fillteredList, data.NameRegex.ValueString(), data.MostRecent.ValueBool(), func(v *serverImageNo) common.ImageSortKey {
+		return common.ImageSortKey{Name: v.Name.ValueString(), CreateDate: v.createDate}
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
 	diags = data.refreshFromOutput(ctx, fillteredList)
 	if diags.HasError() {
 		resp.Diagnostics.AddError("READING ERROR", "refreshFromOutput error")
@@ -234,6 +244,8 @@ type serverImageNumbersDataSourceModel struct {
 	ID              types.String `tfsdk:"id"`
 	ServerImageName types.String `tfsdk:"server_image_name"`
 	HypervisorType  types.String `tfsdk:"hypervisor_type"`
+	NameRegex       types.String `tfsdk:"name_regex"`
+	MostRecent      types.Bool   `tfsdk:"most_recent"`
 	ImageNumberList types.List   `tfsdk:"image_number_list"`
 	OutputFile      types.String `tfsdk:"output_file"`
 	Filters         types.Set    `tfsdk:"filter"`
@@ -250,6 +262,9 @@ type serverImageNo struct {
 	OsType              types.String `tfsdk:"os_type"`
 	ProductCode         types.String `tfsdk:"product_code"`
 	BlockStorageMapList types.List   `tfsdk:"block_storage_mapping_list"`
+
+	// createDate is only used to order images for most_recent
+	createDate string
 }
 
 type blockStorageMap struct {
@@ -340,6 +355,7 @@ func (d *serverImageNo) refreshFromOutput(ctx context.Context, output *vserver.S
 	d.OsCategoryType = types.StringPointerValue(output.OsCategoryType.Code)
 	d.OsType = types.StringPointerValue(output.OsType.Code)
 	d.ProductCode = types.StringPointerValue(output.ServerImageProductCode)
+	d.createDate = ncloud.StringValue(output.CreateDate)
 
 	var blockStorageList []blockStorageMap
 	for _, block := range output.BlockStorageMappingList {
@@ -27,6 +27,32 @@ func TestAccDataSourceNcloudServerImageNumbers_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceNcloudServerImageNumbers_mostRecent(t *testing.T) {
+	dataName := "data.ncloud_server_image_numbers.images"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceServerImageNumbersMostRecentConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataName, "image_number_list.#", "1"),
+					resource.TestMatchResourceAttr(dataName, "image_number_list.0.name", regexp.MustCompile(`^rocky-.*-base$`)),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceServerImageNumbersMostRecentConfig = `
+data "ncloud_server_image_numbers" "images" {
+	hypervisor_type = "KVM"
+	name_regex      = "^rocky-.*-base$"
+	most_recent     = true
+}
+`
+
 var testAccDataSourceServerImageNumbersConfig = `
 data "ncloud_server_image_numbers" "images" { }
 `
@@ -0,0 +1,48 @@
+package server
+
+import (
+	"testing"
+)
+
+func TestExpandServerPreserveOnReplace(t *testing.T) {
+	cases := []struct {
+		name              string
+		input             []interface{}
+		wantBlockStorages bool
+		wantPublicIp      bool
+	}{
+		{name: "unset", input: []interface{}{}, wantBlockStorages: true, wantPublicIp: false},
+		{name: "empty block", input: []interface{}{nil}, wantBlockStorages: true, wantPublicIp: false},
+		{
+			name:              "both",
+			input:             []interface{}{map[string]interface{}{"block_storages": true, "public_ip": true}},
+			wantBlockStorages: true,
+			wantPublicIp:      true,
+		},
+		{
+			name:              "block storages off",
+			input:             []interface{}{map[string]interface{}{"block_storages": false, "public_ip": false}},
+			wantBlockStorages: false,
+			wantPublicIp:      false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			blockStorages, publicIp := expandServerPreserveOnReplace(tc.input)
+			if blockStorages != tc.wantBlockStorages || publicIp != tc.wantPublicIp {
+				t.Errorf("expected (%t, %t), got (%t, %t)", tc.wantBlockStorages, tc.wantPublicIp, blockStorages, publicIp)
+			}
+		})
+	}
+}
+
+func TestServerDataSourcesOmitPreserveOnReplace(t *testing.T) {
+	if _, ok := DataSourceNcloudServer().Schema["preserve_on_replace"]; ok {
+		t.Fatal("expected ncloud_server data source not to expose preserve_on_replace")
+	}
+
+	if _, ok := serversDataSourceItemSchema().Schema["preserve_on_replace"]; ok {
+		t.Fatal("expected ncloud_servers items not to expose preserve_on_replace")
+	}
+}
@@ -0,0 +1,333 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+var (
+	_ datasource.DataSource              = &serverSpecSelectorDataSource{}
+	_ datasource.DataSourceWithConfigure = &serverSpecSelectorDataSource{}
+)
+
+func NewServerSpecSelectorDataSource() datasource.DataSource {
+	return &serverSpecSelectorDataSource{}
+}
+
+type serverSpecSelectorDataSource struct {
+	config *conn.ProviderConfig
+}
+
+func (d *serverSpecSelectorDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_spec_selector"
+}
+
+func (d *serverSpecSelectorDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"server_image_number": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only specs that can be used with this server image are considered.",
+			},
+			"min_cpu_count": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"min_memory_size": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Minimum memory size in GB.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"hypervisor_type": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("XEN", "KVM"),
+				},
+			},
+			"cpu_architecture_type": schema.StringAttribute{
+				Optional: true,
+			},
+			"generation_code": schema.StringAttribute{
+				Optional: true,
+			},
+			"required_block_storage_max_count": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"server_spec_code": schema.StringAttribute{
+				Computed: true,
+			},
+			"cpu_count": schema.Int32Attribute{
+				Computed: true,
+			},
+			"memory_size": schema.Int64Attribute{
+				Computed: true,
+			},
+			"block_storage_max_count": schema.Int32Attribute{
+				Computed: true,
+			},
+			"description": schema.StringAttribute{
+				Computed: true,
+			},
+			"product_code": schema.StringAttribute{
+				Computed: true,
+			},
+			"rejected_server_specs": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"server_spec_code": schema.StringAttribute{
+							Computed: true,
+						},
+						"reasons": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *serverSpecSelectorDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.config = config
+}
+
+func (d *serverSpecSelectorDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data serverSpecSelectorDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqParams := &vserver.GetServerSpecListRequest{
+		RegionCode:    &d.config.RegionCode,
+		ServerImageNo: data.ServerImageNumber.ValueStringPointer(),
+	}
+	tflog.Info(ctx, "GetServerSpecListRequest reqParams="+common.MarshalUncheckedString(reqParams))
+
+	specResp, err := d.config.Client.Vserver.V2Api.GetServerSpecList(reqParams)
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+	tflog.Info(ctx, "GetServerSpecListRequest response="+common.MarshalUncheckedString(specResp))
+
+	if specResp == nil || len(specResp.ServerSpecList) < 1 {
+		resp.Diagnostics.AddError("READING ERROR", "no result.")
+		return
+	}
+
+	selected, rejected := selectServerSpec(specResp.ServerSpecList, data.requirement())
+	if selected == nil {
+		var details []string
+		for _, r := range rejected {
+			details = append(details, fmt.Sprintf("%s: %s", r.ServerSpecCode, strings.Join(r.Reasons, ", ")))
+		}
+		resp.Diagnostics.AddError("READING ERROR", "no server spec matches the given requirements.\n"+strings.Join(details, "\n"))
+		return
+	}
+
+	resp.Diagnostics.Append(data.refreshFromOutput(ctx, selected, rejected)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+type serverSpecSelectorDataSourceModel struct {
+	ID                           types.String `tfsdk:"id"`
+	ServerImageNumber            types.String `tfsdk:"server_image_number"`
+	MinCpuCount                  types.Int64  `tfsdk:"min_cpu_count"`
+	MinMemorySize                types.Int64  `tfsdk:"min_memory_size"`
+	HypervisorType               types.String `tfsdk:"hypervisor_type"`
+	CpuArchitectureType          types.String `tfsdk:"cpu_architecture_type"`
+	GenerationCode               types.String `tfsdk:"generation_code"`
+	RequiredBlockStorageMaxCount types.Int64  `tfsdk:"required_block_storage_max_count"`
+	ServerSpecCode               types.String `tfsdk:"server_spec_code"`
+	CpuCount                     types.Int32  `tfsdk:"cpu_count"`
+	MemorySize                   types.Int64  `tfsdk:"memory_size"`
+	BlockStorageMaxCount         types.Int32  `tfsdk:"block_storage_max_count"`
+	Description                  types.String `tfsdk:"description"`
+	ProductCode                  types.String `tfsdk:"product_code"`
+	RejectedServerSpecs          types.List   `tfsdk:"rejected_server_specs"`
+}
+
+type rejectedServerSpec struct {
+	ServerSpecCode string   `tfsdk:"server_spec_code"`
+	Reasons        []string `tfsdk:"reasons"`
+}
+
+func (r rejectedServerSpec) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"server_spec_code": types.StringType,
+		"reasons":          types.ListType{ElemType: types.StringType},
+	}
+}
+
+// serverSpecRequirement holds the constraints a server spec has to satisfy. Zero values are not checked.
+type serverSpecRequirement struct {
+	MinCpuCount                  int32
+	MinMemorySize                int64 // in bytes
+	HypervisorType               string
+	CpuArchitectureType          string
+	GenerationCode               string
+	RequiredBlockStorageMaxCount int32
+}
+
+func (d *serverSpecSelectorDataSourceModel) requirement() serverSpecRequirement {
+	return serverSpecRequirement{
+		MinCpuCount:                  int32(d.MinCpuCount.ValueInt64()),
+		MinMemorySize:                d.MinMemorySize.ValueInt64() * common.GIGABYTE,
+		HypervisorType:               d.HypervisorType.ValueString(),
+		CpuArchitectureType:          d.CpuArchitectureType.ValueString(),
+		GenerationCode:               d.GenerationCode.ValueString(),
+		RequiredBlockStorageMaxCount: int32(d.RequiredBlockStorageMaxCount.ValueInt64()),
+	}
+}
+
+func (d *serverSpecSelectorDataSourceModel) refreshFromOutput(ctx context.Context, selected *vserver.ServerSpec, rejected []rejectedServerSpec) (diags diag.Diagnostics) {
+	d.ID = types.StringPointerValue(selected.ServerSpecCode)
+	d.ServerSpecCode = types.StringPointerValue(selected.ServerSpecCode)
+	d.CpuCount = types.Int32PointerValue(selected.CpuCount)
+	d.MemorySize = types.Int64PointerValue(selected.MemorySize)
+	d.BlockStorageMaxCount = types.Int32PointerValue(selected.BlockStorageMaxCount)
+	d.Description = types.StringPointerValue(selected.ServerSpecDescription)
+	d.ProductCode = types.StringPointerValue(selected.ServerProductCode)
+
+	if rejected == nil {
+		rejected = []rejectedServerSpec{}
+	}
+	d.RejectedServerSpecs, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: rejectedServerSpec{}.attrTypes()}, rejected)
+
+	return diags
+}
+
+// selectServerSpec returns the smallest spec satisfying req, ordered by CPU count, memory size and spec code
+// so the result is stable between runs, together with the reasons every other spec was rejected.
+// Prices aren't available through the API, so the smallest spec isn't necessarily the cheapest one.
+func selectServerSpec(specs []*vserver.ServerSpec, req serverSpecRequirement) (*vserver.ServerSpec, []rejectedServerSpec) {
+	var matched []*vserver.ServerSpec
+	var rejected []rejectedServerSpec
+
+	for _, s := range specs {
+		if reasons := serverSpecRejectReasons(s, req); len(reasons) > 0 {
+			rejected = append(rejected, rejectedServerSpec{
+				ServerSpecCode: ncloud.StringValue(s.ServerSpecCode),
+				Reasons:        reasons,
+			})
+			continue
+		}
+		matched = append(matched, s)
+	}
+
+	sort.SliceStable(matched, func(i, j int) bool {
+		a, b := matched[i], matched[j]
+		if ncloud.Int32Value(a.CpuCount) != ncloud.Int32Value(b.CpuCount) {
+			return ncloud.Int32Value(a.CpuCount) < ncloud.Int32Value(b.CpuCount)
+		}
+		if ncloud.Int64Value(a.MemorySize) != ncloud.Int64Value(b.MemorySize) {
+			return ncloud.Int64Value(a.MemorySize) < ncloud.Int64Value(b.MemorySize)
+		}
+		return ncloud.StringValue(a.ServerSpecCode) < ncloud.StringValue(b.ServerSpecCode)
+	})
+
+	if len(matched) == 0 {
+		return nil, rejected
+	}
+
+	for _, s := range matched[1:] {
+		rejected = append(rejected, rejectedServerSpec{
+			ServerSpecCode: ncloud.StringValue(s.ServerSpecCode),
+			Reasons:        []string{fmt.Sprintf("larger than selected spec %s", ncloud.StringValue(matched[0].ServerSpecCode))},
+		})
+	}
+
+	sort.SliceStable(rejected, func(i, j int) bool {
+		return rejected[i].ServerSpecCode < rejected[j].ServerSpecCode
+	})
+
+	return matched[0], rejected
+}
+
+func serverSpecRejectReasons(s *vserver.ServerSpec, req serverSpecRequirement) []string {
+	var reasons []string
+
+	if cpu := ncloud.Int32Value(s.CpuCount); req.MinCpuCount > 0 && cpu < req.MinCpuCount {
+		reasons = append(reasons, fmt.Sprintf("cpu_count %d is less than %d", cpu, req.MinCpuCount))
+	}
+
+	if memory := ncloud.Int64Value(s.MemorySize); req.MinMemorySize > 0 && memory < req.MinMemorySize {
+		reasons = append(reasons, fmt.Sprintf("memory_size %dGB is less than %dGB", memory/common.GIGABYTE, req.MinMemorySize/common.GIGABYTE))
+	}
+
+	if hypervisor := serverSpecCommonCode(s.HypervisorType); len(req.HypervisorType) > 0 && hypervisor != req.HypervisorType {
+		reasons = append(reasons, fmt.Sprintf("hypervisor_type is %s, not %s", hypervisor, req.HypervisorType))
+	}
+
+	if architecture := serverSpecCommonCode(s.CpuArchitectureType); len(req.CpuArchitectureType) > 0 && architecture != req.CpuArchitectureType {
+		reasons = append(reasons, fmt.Sprintf("cpu_architecture_type is %s, not %s", architecture, req.CpuArchitectureType))
+	}
+
+	if generation := ncloud.StringValue(s.GenerationCode); len(req.GenerationCode) > 0 && generation != req.GenerationCode {
+		reasons = append(reasons, fmt.Sprintf("generation_code is %s, not %s", generation, req.GenerationCode))
+	}
+
+	if count := ncloud.Int32Value(s.BlockStorageMaxCount); req.RequiredBlockStorageMaxCount > 0 && count < req.RequiredBlockStorageMaxCount {
+		reasons = append(reasons, fmt.Sprintf("block_storage_max_count %d is less than %d", count, req.RequiredBlockStorageMaxCount))
+	}
+
+	return reasons
+}
+
+// serverSpecCommonCode returns "unknown" for codes missing from the response, so they never match a filter.
+func serverSpecCommonCode(c *vserver.CommonCode) string {
+	if c == nil || len(ncloud.StringValue(c.Code)) == 0 {
+		return "unknown"
+	}
+
+	return ncloud.StringValue(c.Code)
+}
@@ -0,0 +1,36 @@
+package server_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+)
+
+func TestAccDataSourceNcloudServerSpecSelector_basic(t *testing.T) {
+	dataName := "data.ncloud_server_spec_selector.selector"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceServerSpecSelectorConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataName, "server_spec_code", "c2-g3"),
+					resource.TestCheckResourceAttr(dataName, "cpu_count", "2"),
+					resource.TestCheckResourceAttrSet(dataName, "rejected_server_specs.#"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDataSourceServerSpecSelectorConfig = `
+data "ncloud_server_spec_selector" "selector" {
+	min_cpu_count   = 2
+	min_memory_size = 4
+	hypervisor_type = "KVM"
+	generation_code = "G3"
+}
+`
@@ -0,0 +1,71 @@
+package server
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+)
+
+func TestSelectServerSpec(t *testing.T) {
+	spec := func(code string, cpu int32, memoryGB int64, generation string, storageMaxCount int32) *vserver.ServerSpec {
+		return &vserver.ServerSpec{
+			ServerSpecCode:       ncloud.String(code),
+			CpuCount:             ncloud.Int32(cpu),
+			MemorySize:           ncloud.Int64(memoryGB * common.GIGABYTE),
+			GenerationCode:       ncloud.String(generation),
+			HypervisorType:       &vserver.CommonCode{Code: ncloud.String("KVM")},
+			CpuArchitectureType:  &vserver.CommonCode{Code: ncloud.String("X86_64")},
+			BlockStorageMaxCount: ncloud.Int32(storageMaxCount),
+		}
+	}
+
+	specs := []*vserver.ServerSpec{
+		spec("s4-g3", 4, 16, "G3", 20),
+		spec("c2-g3", 2, 4, "G3", 20),
+		spec("m4-g3", 4, 32, "G3", 20),
+		spec("s4-g2", 4, 16, "G2", 16),
+		spec("c4-g3", 4, 8, "G3", 20),
+	}
+
+	selected, rejected := selectServerSpec(specs, serverSpecRequirement{
+		MinCpuCount:    4,
+		MinMemorySize:  16 * common.GIGABYTE,
+		GenerationCode: "G3",
+	})
+
+	if code := ncloud.StringValue(selected.ServerSpecCode); code != "s4-g3" {
+		t.Fatalf("expected s4-g3, but got %s", code)
+	}
+
+	expected := []rejectedServerSpec{
+		{ServerSpecCode: "c2-g3", Reasons: []string{"cpu_count 2 is less than 4", "memory_size 4GB is less than 16GB"}},
+		{ServerSpecCode: "c4-g3", Reasons: []string{"memory_size 8GB is less than 16GB"}},
+		{ServerSpecCode: "m4-g3", Reasons: []string{"larger than selected spec s4-g3"}},
+		{ServerSpecCode: "s4-g2", Reasons: []string{"generation_code is G2, not G3"}},
+	}
+	if !reflect.DeepEqual(rejected, expected) {
+		t.Fatalf("expected rejected %v, but got %v", expected, rejected)
+	}
+
+	selected, rejected = selectServerSpec(specs, serverSpecRequirement{RequiredBlockStorageMaxCount: 30})
+	if selected != nil {
+		t.Fatalf("expected no spec to be selected, but got %s", ncloud.StringValue(selected.ServerSpecCode))
+	}
+	if len(rejected) != len(specs) {
+		t.Fatalf("expected all %d specs to be rejected, but got %d", len(specs), len(rejected))
+	}
+
+	unknown := spec("u4-g3", 4, 16, "G3", 20)
+	unknown.HypervisorType = nil
+	selected, rejected = selectServerSpec([]*vserver.ServerSpec{unknown}, serverSpecRequirement{HypervisorType: "KVM"})
+	if selected != nil {
+		t.Fatalf("expected a spec without hypervisor type not to be selected, but got %s", ncloud.StringValue(selected.ServerSpecCode))
+	}
+	if expected := []string{"hypervisor_type is unknown, not KVM"}; len(rejected) != 1 || !reflect.DeepEqual(rejected[0].Reasons, expected) {
+		t.Fatalf("expected rejected reasons %v, but got %v", expected, rejected)
+	}
+}
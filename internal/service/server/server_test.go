@@ -190,6 +190,100 @@ func TestAccResourceNcloudServer_vpc_changeSpec(t *testing.T) {
 	})
 }
 
+func TestAccResourceNcloudServer_vpc_changePlacementGroup(t *testing.T) {
+	var before serverservice.ServerInstance
+	var after serverservice.ServerInstance
+	testServerName := GetTestServerName()
+	resourceName := "ncloud_server.server"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerVpcConfigPlacementGroup(testServerName, "ncloud_placement_group.first.id"),
+				Check: resource.ComposeTestCheckFunc(testAccCheckServerExistsWithProvider(resourceName, &before, TestAccProvider),
+					resource.TestCheckResourceAttrPair(resourceName, "placement_group_no", "ncloud_placement_group.first", "id"),
+				),
+			},
+			{
+				Config: testAccServerVpcConfigPlacementGroup(testServerName, "ncloud_placement_group.second.id"),
+				Check: resource.ComposeTestCheckFunc(testAccCheckServerExistsWithProvider(resourceName, &after, TestAccProvider),
+					resource.TestCheckResourceAttrPair(resourceName, "placement_group_no", "ncloud_placement_group.second", "id"),
+					testAccCheckInstanceNotRecreated(t, &before, &after),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudServer_vpc_preserveOnReplace(t *testing.T) {
+	testServerName := GetTestServerName()
+	resourceName := "ncloud_server.server"
+	var publicIpNo, blockStorageNo string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerVpcConfigPreserveOnReplace(testServerName, testServerName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("ncloud_public_ip.test", "server_instance_no", resourceName, "id"),
+					resource.TestCheckResourceAttrPair("ncloud_block_storage.test", "server_instance_no", resourceName, "id"),
+					testAccCheckResourceAttrCapture("ncloud_public_ip.test", "id", &publicIpNo),
+					testAccCheckResourceAttrCapture("ncloud_block_storage.test", "id", &blockStorageNo),
+				),
+			},
+			{
+				// Changing the name replaces the server
+				Config: testAccServerVpcConfigPreserveOnReplace(testServerName, testServerName+"-new", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", testServerName+"-new"),
+					resource.TestCheckResourceAttrPair("ncloud_public_ip.test", "server_instance_no", resourceName, "id"),
+					resource.TestCheckResourceAttrPair("ncloud_block_storage.test", "server_instance_no", resourceName, "id"),
+					resource.TestCheckResourceAttrPtr("ncloud_public_ip.test", "id", &publicIpNo),
+					resource.TestCheckResourceAttrPtr("ncloud_block_storage.test", "id", &blockStorageNo),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudServer_vpc_preserveOnReplaceCreateBeforeDestroy(t *testing.T) {
+	testServerName := GetTestServerName()
+	resourceName := "ncloud_server.server"
+	var publicIpNo, blockStorageNo string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerVpcConfigPreserveOnReplace(testServerName, testServerName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceAttrCapture("ncloud_public_ip.test", "id", &publicIpNo),
+					testAccCheckResourceAttrCapture("ncloud_block_storage.test", "id", &blockStorageNo),
+				),
+			},
+			{
+				// The new server is created first, and the old one still holds the block storage and public IP
+				Config: testAccServerVpcConfigPreserveOnReplace(testServerName, testServerName+"-new", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", testServerName+"-new"),
+					resource.TestCheckResourceAttrPair("ncloud_public_ip.test", "server_instance_no", resourceName, "id"),
+					resource.TestCheckResourceAttrPair("ncloud_block_storage.test", "server_instance_no", resourceName, "id"),
+					resource.TestCheckResourceAttrPtr("ncloud_public_ip.test", "id", &publicIpNo),
+					resource.TestCheckResourceAttrPtr("ncloud_block_storage.test", "id", &blockStorageNo),
+				),
+			},
+		},
+	})
+}
+
 func TestConvertToMap(t *testing.T) {
 	i := &serverservice.ServerInstance{
 		ZoneNo:                     ncloud.String("KR-1"),
@@ -436,3 +530,106 @@ resource "ncloud_server" "server" {
 }
 `, testServerName, productCode)
 }
+
+func testAccServerVpcConfigPlacementGroup(testServerName, placementGroupNo string) string {
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "loginkey" {
+	key_name = "%[1]s-key"
+}
+
+resource "ncloud_vpc" "test" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.5.0.0/16"
+}
+
+resource "ncloud_subnet" "test" {
+	vpc_no             = ncloud_vpc.test.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.5.0.0/24"
+	zone               = "KR-2"
+	network_acl_no     = ncloud_vpc.test.default_network_acl_no
+	subnet_type        = "PUBLIC"
+	usage_type         = "GEN"
+}
+
+resource "ncloud_placement_group" "first" {
+	name = "%[1]s-1"
+}
+
+resource "ncloud_placement_group" "second" {
+	name = "%[1]s-2"
+}
+
+resource "ncloud_server" "server" {
+	subnet_no = ncloud_subnet.test.id
+	name = "%[1]s"
+	server_image_product_code = "SW.VSVR.OS.LNX64.ROCKY.0810.B050"
+	server_product_code = "SVR.VSVR.STAND.C002.M008.NET.HDD.B050.G002"
+	login_key_name = ncloud_login_key.loginkey.key_name
+	placement_group_no = %[2]s
+}
+`, testServerName, placementGroupNo)
+}
+
+func testAccServerVpcConfigPreserveOnReplace(testServerName, serverName string, createBeforeDestroy bool) string {
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "loginkey" {
+	key_name = "%[1]s-key"
+}
+
+resource "ncloud_vpc" "test" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.5.0.0/16"
+}
+
+resource "ncloud_subnet" "test" {
+	vpc_no             = ncloud_vpc.test.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.5.0.0/24"
+	zone               = "KR-2"
+	network_acl_no     = ncloud_vpc.test.default_network_acl_no
+	subnet_type        = "PUBLIC"
+	usage_type         = "GEN"
+}
+
+resource "ncloud_server" "server" {
+	subnet_no = ncloud_subnet.test.id
+	name = "%[2]s"
+	server_image_product_code = "SW.VSVR.OS.LNX64.ROCKY.0810.B050"
+	server_product_code = "SVR.VSVR.STAND.C002.M008.NET.HDD.B050.G002"
+	login_key_name = ncloud_login_key.loginkey.key_name
+
+	preserve_on_replace {
+		block_storages = true
+		public_ip      = true
+	}
+
+	lifecycle {
+		create_before_destroy = %[3]t
+	}
+}
+
+resource "ncloud_public_ip" "test" {
+	server_instance_no = ncloud_server.server.id
+}
+
+resource "ncloud_block_storage" "test" {
+	server_instance_no = ncloud_server.server.id
+	name = "%[1]s"
+	size = "10"
+	stop_instance_before_detaching = true
+}
+`, testServerName, serverName, createBeforeDestroy)
+}
+
+func testAccCheckResourceAttrCapture(n, key string, v *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		*v = rs.Primary.Attributes[key]
+		return nil
+	}
+}
@@ -3,6 +3,7 @@ package server
 import (
 	"fmt"
 
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
@@ -20,10 +21,39 @@ func DataSourceNcloudServers() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"filter": DataSourceFiltersSchema(),
+			"include_topology": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Read the block storages, network interfaces and public IP of each server. It takes additional API calls per server.",
+			},
+
+			"servers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     serversDataSourceItemSchema(),
+			},
 		},
 	}
 }
 
+func serversDataSourceItemSchema() *schema.Resource {
+	r := serverDataSourceResourceSchema()
+	for k, v := range serverTopologySchema() {
+		r.Schema[k] = v
+	}
+
+	return GetDataSourceItemSchema(r)
+}
+
+// serverDataSourceResourceSchema returns the ncloud_server schema without the fields that only make sense on the resource.
+func serverDataSourceResourceSchema() *schema.Resource {
+	r := ResourceNcloudServer()
+	delete(r.Schema, "preserve_on_replace")
+
+	return r
+}
+
 func dataSourceNcloudServersRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*conn.ProviderConfig)
 
@@ -37,7 +67,11 @@ func dataSourceNcloudServersRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if values, ok := d.GetOk("ids"); ok {
-		return readServersIDs(d, values.(*schema.Set).List(), instances)
+		matched, err := filterServersByIDs(values.(*schema.Set).List(), instances)
+		if err != nil {
+			return err
+		}
+		return setServersDataSource(d, config, matched, ConvertToArrayMap(matched))
 	}
 
 	resources := ConvertToArrayMap(instances)
@@ -49,36 +83,67 @@ func dataSourceNcloudServersRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("no results with filter. there is no available server resource")
 	}
 
+	return setServersDataSource(d, config, instances, resources)
+}
+
+func setServersDataSource(d *schema.ResourceData, config *conn.ProviderConfig, instances []*ServerInstance, resources []map[string]interface{}) error {
+	instanceMap := make(map[string]*ServerInstance, len(instances))
+	for _, instance := range instances {
+		instanceMap[ncloud.StringValue(instance.ServerInstanceNo)] = instance
+	}
+
+	includeTopology := d.Get("include_topology").(bool)
+	itemSchema := serversDataSourceItemSchema().Schema
 	var ids []string
+	var servers []map[string]interface{}
 	for _, r := range resources {
+		instanceNo := r["instance_no"].(string)
+		ids = append(ids, instanceNo)
+
+		server := map[string]interface{}{"id": instanceNo}
 		for k, v := range r {
-			if k == "instance_no" {
-				ids = append(ids, v.(string))
+			if _, ok := itemSchema[k]; ok {
+				server[k] = v
+			}
+		}
+
+		if includeTopology {
+			topology, err := getServerTopology(config, instanceMap[instanceNo])
+			if err != nil {
+				return err
+			}
+
+			for k, v := range topology {
+				server[k] = v
 			}
 		}
+
+		servers = append(servers, server)
 	}
 
 	d.SetId(DataResourceIdHash(ids))
 	d.Set("ids", ids)
+	if err := d.Set("servers", servers); err != nil {
+		return fmt.Errorf("error setting Servers: %s", err)
+	}
+
 	return nil
 }
 
-func readServersIDs(d *schema.ResourceData, values []interface{}, serverInstances []*ServerInstance) error {
-	var ids []string
+func filterServersByIDs(values []interface{}, serverInstances []*ServerInstance) ([]*ServerInstance, error) {
+	var matched []*ServerInstance
 	for _, id := range values {
 		for _, s := range serverInstances {
 			if *s.ServerInstanceNo == id.(string) {
-				ids = append(ids, id.(string))
+				matched = append(matched, s)
 				break
 			}
 		}
 	}
 
-	if len(values) != len(ids) {
-		return fmt.Errorf("invalid server id specified")
+	if len(values) != len(matched) {
+		return nil, fmt.Errorf("invalid server id specified")
 	}
 
-	d.SetId(DataResourceIdHash(ids))
-	d.Set("ids", ids)
-	return nil
+	return matched, nil
 }
@@ -30,9 +30,17 @@ func TestAccDataSourceNcloudServers_vpc_basic(t *testing.T) {
 					TestAccCheckDataSourceID(dataName),
 					resource.TestMatchResourceAttr(dataName, "id", regexp.MustCompile(`^\d+$`)),
 					resource.TestCheckResourceAttr(dataName, "ids.#", "2"),
+					resource.TestCheckResourceAttr(dataName, "servers.#", "2"),
+					resource.TestCheckResourceAttr(dataName, "servers.0.network_interfaces.#", "0"),
 					TestAccCheckDataSourceID(dataName2),
 					resource.TestCheckResourceAttr(dataName2, "ids.#", "1"),
 					resource.TestCheckResourceAttrPair(dataName2, "ids.0", serverName, "id"),
+					resource.TestCheckResourceAttr(dataName2, "servers.#", "1"),
+					resource.TestCheckResourceAttrPair(dataName2, "servers.0.instance_no", serverName, "instance_no"),
+					resource.TestCheckResourceAttrPair(dataName2, "servers.0.name", serverName, "name"),
+					resource.TestCheckResourceAttr(dataName2, "servers.0.block_storages.#", "1"),
+					resource.TestCheckResourceAttr(dataName2, "servers.0.network_interfaces.#", "1"),
+					resource.TestCheckResourceAttrPair(dataName2, "servers.0.network_interfaces.0.network_interface_no", serverName, "network_interface.0.network_interface_no"),
 				),
 			},
 		},
@@ -88,6 +96,8 @@ data "ncloud_servers" "by_id" {
 }
 
 data "ncloud_servers" "by_filter" {
+	include_topology = true
+
 	filter {
 		name = "instance_no"
 		values = [ncloud_server.test.id]
@@ -0,0 +1,275 @@
+package vpc
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+// ResourceNcloudDefaultNetworkACL adopts the Network ACL created with a VPC.
+// The Network ACL is never created or deleted: the rules and description found when the resource takes ownership
+// are kept in `default_*` attributes and put back on destroy.
+func ResourceNcloudDefaultNetworkACL() *schema.Resource {
+	ruleSchema := ResourceNcloudNetworkACLRule().Schema
+
+	return &schema.Resource{
+		Create: resourceNcloudDefaultNetworkACLCreate,
+		Read:   resourceNcloudDefaultNetworkACLRead,
+		Update: resourceNcloudDefaultNetworkACLUpdate,
+		Delete: resourceNcloudDefaultNetworkACLDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNcloudDefaultNetworkACLImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"vpc_no": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(0, 1000)),
+			},
+			"inbound":  ruleSchema["inbound"],
+			"outbound": ruleSchema["outbound"],
+			"network_acl_no": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Description found when the resource took ownership of the Network ACL, restored on destroy.",
+			},
+			"default_inbound": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        ruleSchema["inbound"].Elem,
+				Description: "Inbound rules found when the resource took ownership of the Network ACL, restored on destroy.",
+			},
+			"default_outbound": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        ruleSchema["outbound"].Elem,
+				Description: "Outbound rules found when the resource took ownership of the Network ACL, restored on destroy.",
+			},
+		},
+	}
+}
+
+func resourceNcloudDefaultNetworkACLCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+	vpcNo := d.Get("vpc_no").(string)
+
+	networkACLNo, err := getDefaultNetworkACL(config, vpcNo)
+	if err != nil {
+		return err
+	}
+
+	if err := snapshotDefaultNetworkACL(d, config, networkACLNo); err != nil {
+		return err
+	}
+
+	d.SetId(networkACLNo)
+	log.Printf("[INFO] Adopted default Network ACL ID: %s", d.Id())
+
+	for _, ruleType := range []string{"inbound", "outbound"} {
+		current := d.Get("default_" + ruleType).(*schema.Set)
+		desired := d.Get(ruleType).(*schema.Set)
+		if err := reconcileNetworkACLRules(d, config, networkACLNo, ruleType, current, desired); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		if err := setDefaultNetworkACLDescription(config, networkACLNo, v.(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceNcloudDefaultNetworkACLRead(d, meta)
+}
+
+func resourceNcloudDefaultNetworkACLRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+
+	instance, err := GetNetworkACLInstance(config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if instance == nil {
+		log.Printf("[WARN] Default Network ACL (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	rules, err := GetNetworkACLRuleList(config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Set("vpc_no", instance.VpcNo)
+	d.Set("network_acl_no", instance.NetworkAclNo)
+	d.Set("name", instance.NetworkAclName)
+	d.Set("description", instance.NetworkAclDescription)
+
+	iSet, oSet := flattenNetworkACLRules(rules)
+
+	if err := d.Set("inbound", iSet.List()); err != nil {
+		log.Printf("[WARN] Error setting inbound rule set for (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("outbound", oSet.List()); err != nil {
+		log.Printf("[WARN] Error setting outbound rule set for (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceNcloudDefaultNetworkACLUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+
+	for _, ruleType := range []string{"inbound", "outbound"} {
+		if d.HasChange(ruleType) {
+			if err := updateNetworkACLRule(d, config, ruleType); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("description") {
+		if err := setDefaultNetworkACLDescription(config, d.Id(), d.Get("description").(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceNcloudDefaultNetworkACLRead(d, meta)
+}
+
+// resourceNcloudDefaultNetworkACLDelete puts back the rules and description captured when the resource took ownership.
+// The Network ACL itself is deleted along with its VPC.
+func resourceNcloudDefaultNetworkACLDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+
+	instance, err := GetNetworkACLInstance(config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if instance == nil {
+		return nil
+	}
+
+	rules, err := GetNetworkACLRuleList(config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	_ = waitForNcloudNetworkACLRunning(config, d.Id())
+
+	iSet, oSet := flattenNetworkACLRules(rules)
+
+	if err := reconcileNetworkACLRules(d, config, d.Id(), "inbound", iSet, d.Get("default_inbound").(*schema.Set)); err != nil {
+		return err
+	}
+
+	if err := reconcileNetworkACLRules(d, config, d.Id(), "outbound", oSet, d.Get("default_outbound").(*schema.Set)); err != nil {
+		return err
+	}
+
+	if ncloud.StringValue(instance.NetworkAclDescription) != d.Get("default_description").(string) {
+		return setDefaultNetworkACLDescription(config, d.Id(), d.Get("default_description").(string))
+	}
+
+	return nil
+}
+
+func resourceNcloudDefaultNetworkACLImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*conn.ProviderConfig)
+
+	if err := snapshotDefaultNetworkACL(d, config, d.Id()); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// snapshotDefaultNetworkACL stores the current rules and description of the default Network ACL in the `default_*` attributes.
+func snapshotDefaultNetworkACL(d *schema.ResourceData, config *conn.ProviderConfig, networkACLNo string) error {
+	instance, err := GetNetworkACLInstance(config, networkACLNo)
+	if err != nil {
+		return err
+	}
+
+	if instance == nil {
+		return fmt.Errorf("no matching Network ACL: %s", networkACLNo)
+	}
+
+	if !ncloud.BoolValue(instance.IsDefault) {
+		return fmt.Errorf("network ACL (%s) is not the default Network ACL of VPC (%s)", networkACLNo, ncloud.StringValue(instance.VpcNo))
+	}
+
+	rules, err := GetNetworkACLRuleList(config, networkACLNo)
+	if err != nil {
+		return err
+	}
+
+	iSet, oSet := flattenNetworkACLRules(rules)
+
+	d.Set("vpc_no", instance.VpcNo)
+	d.Set("default_description", ncloud.StringValue(instance.NetworkAclDescription))
+	if err := d.Set("default_inbound", iSet.List()); err != nil {
+		return err
+	}
+
+	return d.Set("default_outbound", oSet.List())
+}
+
+// reconcileNetworkACLRules removes the rules of current missing from desired, then adds the rules of desired missing from current.
+func reconcileNetworkACLRules(d *schema.ResourceData, config *conn.ProviderConfig, networkACLNo, ruleType string, current, desired *schema.Set) error {
+	if remove := expandRemoveNetworkAclRule(current.Difference(desired).List()); len(remove) > 0 {
+		if err := removeNetworkACLRule(d, config, networkACLNo, ruleType, remove); err != nil {
+			return err
+		}
+	}
+
+	if add := expandAddNetworkAclRule(desired.Difference(current).List()); len(add) > 0 {
+		if err := addNetworkACLRule(d, config, networkACLNo, ruleType, add); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func setDefaultNetworkACLDescription(config *conn.ProviderConfig, networkACLNo, description string) error {
+	reqParams := &vpc.SetNetworkAclDescriptionRequest{
+		RegionCode:            &config.RegionCode,
+		NetworkAclNo:          ncloud.String(networkACLNo),
+		NetworkAclDescription: ncloud.String(description),
+	}
+
+	LogCommonRequest("setNetworkAclDescription", reqParams)
+	resp, err := config.Client.Vpc.V2Api.SetNetworkAclDescription(reqParams)
+	if err != nil {
+		LogErrorResponse("setNetworkAclDescription", err, reqParams)
+		return err
+	}
+	LogResponse("setNetworkAclDescription", resp)
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package vpc_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+)
+
+func TestAccResourceNcloudDefaultNetworkACL_basic(t *testing.T) {
+	var networkACLRule []*vpc.NetworkAclRule
+
+	resourceName := "ncloud_default_network_acl.test"
+	name := fmt.Sprintf("test-default-nacl-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudDefaultNetworkACLConfig(name, "80"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkACLRuleExists(resourceName, &networkACLRule),
+					resource.TestMatchResourceAttr(resourceName, "network_acl_no", regexp.MustCompile(`^\d+$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "network_acl_no", "ncloud_vpc.test", "default_network_acl_no"),
+					resource.TestCheckResourceAttr(resourceName, "description", "managed by terraform"),
+					resource.TestCheckResourceAttr(resourceName, "inbound.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "inbound.0.port_range", "80"),
+					resource.TestCheckResourceAttr(resourceName, "outbound.#", "1"),
+				),
+			},
+			{
+				Config: testAccResourceNcloudDefaultNetworkACLConfig(name, "443"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "inbound.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "inbound.0.port_range", "443"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"default_description", "default_inbound", "default_outbound"},
+			},
+		},
+	})
+}
+
+func testAccResourceNcloudDefaultNetworkACLConfig(name, port string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "test" {
+	name            = "%[1]s"
+	ipv4_cidr_block = "10.3.0.0/16"
+}
+
+resource "ncloud_default_network_acl" "test" {
+	vpc_no      = ncloud_vpc.test.vpc_no
+	description = "managed by terraform"
+
+	inbound {
+		priority    = 10
+		protocol    = "TCP"
+		rule_action = "ALLOW"
+		port_range  = "%[2]s"
+		ip_block    = "0.0.0.0/0"
+	}
+
+	outbound {
+		priority    = 10
+		protocol    = "TCP"
+		rule_action = "ALLOW"
+		port_range  = "1-65535"
+		ip_block    = "0.0.0.0/0"
+	}
+}
+`, name, port)
+}
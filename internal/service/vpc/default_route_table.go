@@ -0,0 +1,257 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+// ResourceNcloudDefaultRouteTable adopts the public or private Route Table created with a VPC.
+// The Route Table is never created or deleted: the routes and description found when the resource takes ownership
+// are kept in `default_route` and `default_description` and put back on destroy.
+func ResourceNcloudDefaultRouteTable() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNcloudDefaultRouteTableCreate,
+		Read:   resourceNcloudDefaultRouteTableRead,
+		Update: resourceNcloudDefaultRouteTableUpdate,
+		Delete: resourceNcloudDefaultRouteTableDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNcloudDefaultRouteTableImport,
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			return checkRouteTableRouteTargets(ctx, d, meta.(*conn.ProviderConfig))
+		},
+		Schema: map[string]*schema.Schema{
+			"vpc_no": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"supported_subnet_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"PUBLIC", "PRIVATE"}, false)),
+			},
+			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(0, 1000)),
+			},
+			"route": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				ConfigMode:  schema.SchemaConfigModeAttr,
+				Elem:        routeTableRouteSchema,
+				Description: "Authoritative list of the routes of the Route Table, except the default ones. When omitted, routes are left to `ncloud_route`.",
+			},
+			"route_table_no": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Description found when the resource took ownership of the Route Table, restored on destroy.",
+			},
+			"default_route": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        routeTableRouteSchema,
+				Description: "Routes found when the resource took ownership of the Route Table, restored on destroy.",
+			},
+		},
+	}
+}
+
+func resourceNcloudDefaultRouteTableCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+	vpcNo := d.Get("vpc_no").(string)
+
+	publicRouteTableNo, privateRouteTableNo, err := getDefaultRouteTable(config, vpcNo)
+	if err != nil {
+		return err
+	}
+
+	routeTableNo := publicRouteTableNo
+	if d.Get("supported_subnet_type").(string) == "PRIVATE" {
+		routeTableNo = privateRouteTableNo
+	}
+
+	if len(routeTableNo) == 0 {
+		return fmt.Errorf("no matching default %s Route Table found in VPC (%s)", d.Get("supported_subnet_type").(string), vpcNo)
+	}
+
+	if err := snapshotDefaultRouteTable(d, config, routeTableNo); err != nil {
+		return err
+	}
+
+	d.SetId(routeTableNo)
+	log.Printf("[INFO] Adopted default Route Table ID: %s", d.Id())
+
+	if !d.GetRawConfig().GetAttr("route").IsNull() {
+		current := d.Get("default_route").(*schema.Set)
+		desired := d.Get("route").(*schema.Set)
+		if err := reconcileRoutes(config, d.Timeout(schema.TimeoutCreate), vpcNo, routeTableNo, current, desired); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		if err := setDefaultRouteTableDescription(config, routeTableNo, v.(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceNcloudDefaultRouteTableRead(d, meta)
+}
+
+func resourceNcloudDefaultRouteTableRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+
+	instance, err := GetRouteTableInstance(config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if instance == nil {
+		log.Printf("[WARN] Default Route Table (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("vpc_no", instance.VpcNo)
+	d.Set("supported_subnet_type", instance.SupportedSubnetType.Code)
+	d.Set("route_table_no", instance.RouteTableNo)
+	d.Set("name", instance.RouteTableName)
+	d.Set("description", instance.RouteTableDescription)
+
+	routes, err := GetRouteList(config, *instance.VpcNo, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("route", flattenRoutes(routes).List()); err != nil {
+		log.Printf("[WARN] Error setting route set for (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceNcloudDefaultRouteTableUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+
+	if d.HasChange("description") {
+		if err := setDefaultRouteTableDescription(config, d.Id(), d.Get("description").(string)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("route") {
+		o, n := d.GetChange("route")
+		if err := reconcileRoutes(config, d.Timeout(schema.TimeoutUpdate), d.Get("vpc_no").(string), d.Id(), o.(*schema.Set), n.(*schema.Set)); err != nil {
+			return err
+		}
+	}
+
+	return resourceNcloudDefaultRouteTableRead(d, meta)
+}
+
+// resourceNcloudDefaultRouteTableDelete puts back the routes and description captured when the resource took ownership.
+// The Route Table itself is deleted along with its VPC.
+func resourceNcloudDefaultRouteTableDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+
+	instance, err := GetRouteTableInstance(config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if instance == nil {
+		return nil
+	}
+
+	routes, err := GetRouteList(config, *instance.VpcNo, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := reconcileRoutes(config, d.Timeout(schema.TimeoutDelete), *instance.VpcNo, d.Id(), flattenRoutes(routes), d.Get("default_route").(*schema.Set)); err != nil {
+		return err
+	}
+
+	if ncloud.StringValue(instance.RouteTableDescription) == d.Get("default_description").(string) {
+		return nil
+	}
+
+	return setDefaultRouteTableDescription(config, d.Id(), d.Get("default_description").(string))
+}
+
+func resourceNcloudDefaultRouteTableImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*conn.ProviderConfig)
+
+	if err := snapshotDefaultRouteTable(d, config, d.Id()); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// snapshotDefaultRouteTable stores the current routes and description of the default Route Table in `default_route` and `default_description`.
+func snapshotDefaultRouteTable(d *schema.ResourceData, config *conn.ProviderConfig, routeTableNo string) error {
+	instance, err := GetRouteTableInstance(config, routeTableNo)
+	if err != nil {
+		return err
+	}
+
+	if instance == nil {
+		return fmt.Errorf("no matching Route Table: %s", routeTableNo)
+	}
+
+	if !ncloud.BoolValue(instance.IsDefault) {
+		return fmt.Errorf("route table (%s) is not a default Route Table of VPC (%s)", routeTableNo, ncloud.StringValue(instance.VpcNo))
+	}
+
+	d.Set("vpc_no", instance.VpcNo)
+	d.Set("supported_subnet_type", instance.SupportedSubnetType.Code)
+	d.Set("default_description", ncloud.StringValue(instance.RouteTableDescription))
+
+	routes, err := GetRouteList(config, *instance.VpcNo, routeTableNo)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("default_route", flattenRoutes(routes).List())
+}
+
+func setDefaultRouteTableDescription(config *conn.ProviderConfig, routeTableNo, description string) error {
+	reqParams := &vpc.SetRouteTableDescriptionRequest{
+		RegionCode:            &config.RegionCode,
+		RouteTableNo:          ncloud.String(routeTableNo),
+		RouteTableDescription: ncloud.String(description),
+	}
+
+	LogCommonRequest("setRouteTableDescription", reqParams)
+	resp, err := config.Client.Vpc.V2Api.SetRouteTableDescription(reqParams)
+	if err != nil {
+		LogErrorResponse("setRouteTableDescription", err, reqParams)
+		return err
+	}
+	LogResponse("setRouteTableDescription", resp)
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+)
+
+func TestAccResourceNcloudDefaultRouteTable_basic(t *testing.T) {
+	var routeTable vpc.RouteTable
+
+	resourceName := "ncloud_default_route_table.test"
+	name := fmt.Sprintf("test-default-rt-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudDefaultRouteTableConfig(name, "managed by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableExists(resourceName, &routeTable),
+					resource.TestCheckResourceAttrPair(resourceName, "route_table_no", "ncloud_vpc.test", "default_private_route_table_no"),
+					resource.TestCheckResourceAttr(resourceName, "supported_subnet_type", "PRIVATE"),
+					resource.TestCheckResourceAttr(resourceName, "description", "managed by terraform"),
+				),
+			},
+			{
+				Config: testAccResourceNcloudDefaultRouteTableConfig(name, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"default_description", "default_route"},
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudDefaultRouteTable_routes(t *testing.T) {
+	resourceName := "ncloud_default_route_table.test"
+	name := fmt.Sprintf("test-default-rt-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudDefaultRouteTableConfigRoutes(name, `["10.10.0.0/16"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "route.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_route.#", "0"),
+				),
+			},
+			{
+				Config: testAccResourceNcloudDefaultRouteTableConfigRoutes(name, `["10.20.0.0/16", "10.30.0.0/16"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "route.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"destination_cidr_block": "10.30.0.0/16",
+						"target_type":            "NATGW",
+					}),
+				),
+			},
+			{
+				Config: testAccResourceNcloudDefaultRouteTableConfigRoutes(name, `[]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "route.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceNcloudDefaultRouteTableConfig(name, description string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "test" {
+	name            = "%[1]s"
+	ipv4_cidr_block = "10.3.0.0/16"
+}
+
+resource "ncloud_default_route_table" "test" {
+	vpc_no                = ncloud_vpc.test.vpc_no
+	supported_subnet_type = "PRIVATE"
+	description           = "%[2]s"
+}
+`, name, description)
+}
+
+func testAccResourceNcloudDefaultRouteTableConfigRoutes(name, destinations string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "test" {
+	name            = "%[1]s"
+	ipv4_cidr_block = "10.3.0.0/16"
+}
+
+resource "ncloud_subnet" "test" {
+	vpc_no         = ncloud_vpc.test.id
+	subnet         = cidrsubnet(ncloud_vpc.test.ipv4_cidr_block, 8, 1)
+	zone           = "KR-1"
+	network_acl_no = ncloud_vpc.test.default_network_acl_no
+	subnet_type    = "PUBLIC"
+	usage_type     = "NATGW"
+}
+
+resource "ncloud_nat_gateway" "test" {
+	vpc_no    = ncloud_vpc.test.id
+	subnet_no = ncloud_subnet.test.id
+	zone      = "KR-1"
+}
+
+resource "ncloud_default_route_table" "test" {
+	vpc_no                = ncloud_vpc.test.vpc_no
+	supported_subnet_type = "PRIVATE"
+
+	route = [for cidr in %[2]s : {
+		destination_cidr_block = cidr
+		target_type            = "NATGW"
+		target_name            = ncloud_nat_gateway.test.name
+		target_no              = ncloud_nat_gateway.test.id
+	}]
+}
+`, name, destinations)
+}
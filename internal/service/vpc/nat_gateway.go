@@ -25,9 +25,11 @@ import (
 )
 
 var (
-	_ resource.Resource                = &natGatewayResource{}
-	_ resource.ResourceWithConfigure   = &natGatewayResource{}
-	_ resource.ResourceWithImportState = &natGatewayResource{}
+	_ resource.Resource                   = &natGatewayResource{}
+	_ resource.ResourceWithConfigure      = &natGatewayResource{}
+	_ resource.ResourceWithImportState    = &natGatewayResource{}
+	_ resource.ResourceWithValidateConfig = &natGatewayResource{}
+	_ resource.ResourceWithModifyPlan     = &natGatewayResource{}
 )
 
 func NewNatGatewayResource() resource.Resource {
@@ -91,11 +93,27 @@ func (n *natGatewayResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"PUBLIC", "PRIVATE"}...),
+				},
+				Description: "`PUBLIC` NAT Gateways are created in a public subnet and reach the internet, `PRIVATE` ones are created in a private subnet. " +
+					"The API derives the type from the subnet, so this must match the type of `subnet_no`.",
+			},
 			"public_ip_no": schema.StringAttribute{
+				Optional: true,
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
 				},
+				Description: "Public IP to assign to a `PUBLIC` NAT Gateway. A new public IP is allocated when omitted.",
 			},
 			"nat_gateway_no": schema.StringAttribute{
 				Computed: true,
@@ -137,6 +155,51 @@ func (n *natGatewayResource) Configure(_ context.Context, req resource.Configure
 	n.config = config
 }
 
+func (n *natGatewayResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config natGatewayResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Type.ValueString() == "PRIVATE" && !config.PublicIpNo.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("public_ip_no"),
+			"Invalid Attribute Combination",
+			"public_ip_no can't be set on a PRIVATE NAT Gateway",
+		)
+	}
+}
+
+func (n *natGatewayResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan natGatewayResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.SubnetNo.IsNull() || plan.SubnetNo.IsUnknown() || plan.Type.IsNull() || plan.Type.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state natGatewayResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || (plan.SubnetNo.Equal(state.SubnetNo) && plan.Type.Equal(state.Type)) {
+			return
+		}
+	}
+
+	if err := checkNatGatewaySubnetType(n.config, plan.SubnetNo.ValueString(), plan.Type.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("type"), "Invalid Attribute Combination", err.Error())
+	}
+}
+
 func (n *natGatewayResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan natGatewayResourceModel
 
@@ -150,6 +213,14 @@ func (n *natGatewayResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	// The subnet may have been unknown at plan time
+	if !plan.Type.IsNull() && !plan.Type.IsUnknown() {
+		if err := checkNatGatewaySubnetType(n.config, plan.SubnetNo.ValueString(), plan.Type.ValueString()); err != nil {
+			resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+			return
+		}
+	}
+
 	reqParams := &vpc.CreateNatGatewayInstanceRequest{
 		RegionCode: &n.config.RegionCode,
 		VpcNo:      plan.VpcNo.ValueStringPointer(),
@@ -169,6 +240,10 @@ func (n *natGatewayResource) Create(ctx context.Context, req resource.CreateRequ
 		reqParams.PrivateIp = plan.PrivateIp.ValueStringPointer()
 	}
 
+	if !plan.PublicIpNo.IsNull() && !plan.PublicIpNo.IsUnknown() {
+		reqParams.PublicIpInstanceNo = plan.PublicIpNo.ValueStringPointer()
+	}
+
 	tflog.Info(ctx, "CreateNatGateway reqParams="+common.MarshalUncheckedString(reqParams))
 
 	response, err := n.config.Client.Vpc.V2Api.CreateNatGatewayInstance(reqParams)
@@ -282,6 +357,24 @@ func (n *natGatewayResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 }
 
+// checkNatGatewaySubnetType returns an error when natGatewayType doesn't match the type of subnetNo, from which the API derives it.
+func checkNatGatewaySubnetType(config *conn.ProviderConfig, subnetNo, natGatewayType string) error {
+	subnet, err := GetSubnetInstance(config, subnetNo)
+	if err != nil {
+		return err
+	}
+
+	if subnet == nil {
+		return fmt.Errorf("no matching subnet: %s", subnetNo)
+	}
+
+	if subnetType := ncloud.StringValue(common.GetCodePtrByCommonCode(subnet.SubnetType)); subnetType != natGatewayType {
+		return fmt.Errorf("a %s NAT Gateway must be created in a %s subnet, but subnet (%s) is %s", natGatewayType, natGatewayType, subnetNo, subnetType)
+	}
+
+	return nil
+}
+
 func waitForNcloudNatGatewayCreation(ctx context.Context, config *conn.ProviderConfig, id string) (*vpc.NatGatewayInstance, error) {
 	var natGatewayInstance *vpc.NatGatewayInstance
 	stateConf := &retry.StateChangeConf{
@@ -353,6 +446,7 @@ type natGatewayResourceModel struct {
 	Zone         types.String `tfsdk:"zone"`
 	SubnetNo     types.String `tfsdk:"subnet_no"`
 	PrivateIp    types.String `tfsdk:"private_ip"`
+	Type         types.String `tfsdk:"type"`
 	PublicIpNo   types.String `tfsdk:"public_ip_no"`
 	NatGatewayNo types.String `tfsdk:"nat_gateway_no"`
 	PublicIp     types.String `tfsdk:"public_ip"`
@@ -368,6 +462,7 @@ func (m *natGatewayResourceModel) refreshFromOutput(output *vpc.NatGatewayInstan
 	m.Zone = types.StringPointerValue(output.ZoneCode)
 	m.SubnetNo = types.StringPointerValue(output.SubnetNo)
 	m.PrivateIp = types.StringPointerValue(output.PrivateIp)
+	m.Type = types.StringPointerValue(common.GetCodePtrByCommonCode(output.NatGatewayType))
 	m.PublicIpNo = types.StringPointerValue(output.PublicIpInstanceNo)
 	m.PublicIp = types.StringPointerValue(output.PublicIp)
 	m.SubnetName = types.StringPointerValue(output.SubnetName)
@@ -62,6 +62,9 @@ func (n *natGatewayDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 			"private_ip": schema.StringAttribute{
 				Computed: true,
 			},
+			"type": schema.StringAttribute{
+				Computed: true,
+			},
 			"public_ip_no": schema.StringAttribute{
 				Computed: true,
 			},
@@ -180,6 +183,7 @@ type natGatewayDataSourceModel struct {
 	SubnetNo     types.String `tfsdk:"subnet_no"`
 	SubnetName   types.String `tfsdk:"subnet_name"`
 	PrivateIp    types.String `tfsdk:"private_ip"`
+	Type         types.String `tfsdk:"type"`
 	PublicIpNo   types.String `tfsdk:"public_ip_no"`
 	Filters      types.Set    `tfsdk:"filter"`
 }
@@ -196,5 +200,6 @@ func (d *natGatewayDataSourceModel) refreshFromOutput(output *vpc.NatGatewayInst
 	d.SubnetNo = types.StringPointerValue(output.SubnetNo)
 	d.SubnetName = types.StringPointerValue(output.SubnetName)
 	d.PrivateIp = types.StringPointerValue(output.PrivateIp)
+	d.Type = types.StringPointerValue(common.GetCodePtrByCommonCode(output.NatGatewayType))
 	d.PublicIpNo = types.StringPointerValue(output.PublicIpInstanceNo)
 }
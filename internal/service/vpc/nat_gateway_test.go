@@ -35,10 +35,13 @@ func TestAccResourceNcloudNatGateway_basic(t *testing.T) {
 					resource.TestMatchResourceAttr(resourceName, "vpc_no", regexp.MustCompile(`^\d+$`)),
 					resource.TestMatchResourceAttr(resourceName, "nat_gateway_no", regexp.MustCompile(`^\d+$`)),
 					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "type", "PUBLIC"),
+					resource.TestMatchResourceAttr(resourceName, "public_ip_no", regexp.MustCompile(`^\d+$`)),
 
 					testAccCheckNatGatewayExists(resourcePrivate, &natGateway),
 					resource.TestMatchResourceAttr(resourcePrivate, "vpc_no", regexp.MustCompile(`^\d+$`)),
 					resource.TestMatchResourceAttr(resourcePrivate, "nat_gateway_no", regexp.MustCompile(`^\d+$`)),
+					resource.TestCheckResourceAttr(resourcePrivate, "type", "PRIVATE"),
 				),
 			},
 			{
@@ -160,6 +163,41 @@ func TestAccResourceNcloudNatGateway_description(t *testing.T) {
 	})
 }
 
+func TestAccResourceNcloudNatGateway_typeMismatch(t *testing.T) {
+	name := fmt.Sprintf("test-nat-gateway-%s", sdkacctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckNatGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceNcloudNatGatewayConfigType(name, "PRIVATE"),
+				ExpectError: regexp.MustCompile("a PRIVATE NAT Gateway must be created in a PRIVATE subnet"),
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudNatGateway_privateDefaultRoute(t *testing.T) {
+	name := fmt.Sprintf("test-nat-gateway-%s", sdkacctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckNatGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudNatGatewayConfig(name),
+			},
+			{
+				Config:      testAccResourceNcloudNatGatewayConfigPrivateDefaultRoute(name),
+				ExpectError: regexp.MustCompile("is a PRIVATE NAT Gateway and can't be the target of a route to 0.0.0.0/0"),
+			},
+		},
+	})
+}
+
 func testAccResourceNcloudNatGatewayConfig(name string) string {
 	return testAccResourceNcloudNatGatewayConfigDescription(name, "for acc test")
 }
@@ -206,6 +244,48 @@ resource "ncloud_nat_gateway" "nat_gateway_private" {
 `, name, description)
 }
 
+func testAccResourceNcloudNatGatewayConfigType(name, natGatewayType string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "vpc" {
+	name            = "%[1]s"
+	ipv4_cidr_block = "10.3.0.0/16"
+}
+
+resource "ncloud_subnet" "subnet_public" {
+  vpc_no         = ncloud_vpc.vpc.id
+  subnet         = cidrsubnet(ncloud_vpc.vpc.ipv4_cidr_block, 8, 1)
+  zone           = "KR-1"
+  network_acl_no = ncloud_vpc.vpc.default_network_acl_no
+  subnet_type    = "PUBLIC"
+  usage_type     = "NATGW"
+}
+
+resource "ncloud_nat_gateway" "nat_gateway" {
+  vpc_no    = ncloud_vpc.vpc.vpc_no
+  subnet_no = ncloud_subnet.subnet_public.id
+  zone      = "KR-1"
+  type      = "%[2]s"
+}
+`, name, natGatewayType)
+}
+
+func testAccResourceNcloudNatGatewayConfigPrivateDefaultRoute(name string) string {
+	return testAccResourceNcloudNatGatewayConfig(name) + `
+resource "ncloud_route_table" "route_table" {
+  vpc_no                = ncloud_vpc.vpc.vpc_no
+  supported_subnet_type = "PRIVATE"
+}
+
+resource "ncloud_route" "route" {
+  route_table_no         = ncloud_route_table.route_table.id
+  destination_cidr_block = "0.0.0.0/0"
+  target_type            = "NATGW"
+  target_name            = ncloud_nat_gateway.nat_gateway_private.name
+  target_no              = ncloud_nat_gateway.nat_gateway_private.id
+}
+`
+}
+
 func testAccResourceNcloudNatGatewayConfigOnlyRequiredParam(name string) string {
 	return fmt.Sprintf(`
 resource "ncloud_vpc" "vpc" {
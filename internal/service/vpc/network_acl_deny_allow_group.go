@@ -1,8 +1,12 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,6 +30,7 @@ func ResourceNcloudNetworkACLDenyAllowGroup() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceNcloudNetworkACLDenyAllowGroupCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"network_acl_deny_allow_group_no": {
 				Type:     schema.TypeString,
@@ -53,8 +58,35 @@ func ResourceNcloudNetworkACLDenyAllowGroup() *schema.Resource {
 				Type:     schema.TypeSet,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
-				MaxItems: 100,
-				Required: true,
+				MaxItems: networkAclDenyAllowGroupMaxIps,
+				Optional: true,
+				Computed: true,
+			},
+			"ip_list_content": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     []string{"ip_list", "ip_list_content"},
+				ValidateDiagFunc: validation.ToDiagFunc(validateNetworkAclDenyAllowGroupIpListContent),
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					oldIps, oldErr := parseNetworkAclDenyAllowGroupIpListContent(old)
+					newIps, newErr := parseNetworkAclDenyAllowGroupIpListContent(new)
+					return oldErr == nil && newErr == nil && len(old) > 0 && len(new) > 0 && strings.Join(oldIps, ",") == strings.Join(newIps, ",")
+				},
+				Description: "IP addresses and CIDR blocks, one per line, such as the content of a blocklist file. Blank lines and `#` comments are ignored.",
+			},
+			"ip_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"ip_list_added_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of entries added by the last change of the IP list.",
+			},
+			"ip_list_removed_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of entries removed by the last change of the IP list.",
 			},
 		},
 	}
@@ -122,18 +154,31 @@ func resourceNcloudNetworkACLDenyAllowGroupRead(d *schema.ResourceData, meta int
 		"vpc_no":                          *instance.VpcNo,
 		"name":                            *instance.NetworkAclDenyAllowGroupName,
 		"description":                     *instance.NetworkAclDenyAllowGroupDescription,
-		"ip_list":                         instance.IpList,
+		"ip_count":                        len(instance.IpList),
 	}
 
 	SetSingularResourceDataFromMapSchema(ResourceNcloudNetworkACLDenyAllowGroup(), d, m)
 
+	// With `ip_list_content`, the content is only rewritten when the IP list drifted,
+	// so that the comments and layout of the configured content are kept otherwise.
+	if content, ok := d.GetOk("ip_list_content"); ok {
+		ips, _ := parseNetworkAclDenyAllowGroupIpListContent(content.(string))
+		current := normalizeNetworkAclDenyAllowGroupIpList(ncloud.StringListValue(instance.IpList))
+		if strings.Join(ips, ",") != strings.Join(current, ",") {
+			d.Set("ip_list_content", strings.Join(current, "\n")+"\n")
+		}
+		d.Set("ip_list", nil)
+	} else {
+		d.Set("ip_list", instance.IpList)
+	}
+
 	return nil
 }
 
 func resourceNcloudNetworkACLDenyAllowGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*conn.ProviderConfig)
 
-	if d.HasChange("ip_list") {
+	if d.HasChanges("ip_list", "ip_list_content") {
 		if err := setNetworkAclDenyAllowGroupIpList(d, config); err != nil {
 			return err
 		}
@@ -237,11 +282,18 @@ func setNetworkAclDenyAllowGroupDescription(d *schema.ResourceData, config *conn
 	return nil
 }
 
+// setNetworkAclDenyAllowGroupIpList replaces the IP list of the Deny-Allow Group.
+// The API has no call to add or remove single IPs, so the whole list is sent on every change.
 func setNetworkAclDenyAllowGroupIpList(d *schema.ResourceData, config *conn.ProviderConfig) error {
+	ips, err := expandNetworkAclDenyAllowGroupIpList(d)
+	if err != nil {
+		return err
+	}
+
 	reqParams := &vpc.SetNetworkAclDenyAllowGroupIpListRequest{
 		RegionCode:                 &config.RegionCode,
 		NetworkAclDenyAllowGroupNo: ncloud.String(d.Id()),
-		IpList:                     ExpandStringSet(d.Get("ip_list").(*schema.Set)),
+		IpList:                     ncloud.StringList(ips),
 	}
 
 	LogCommonRequest("SetNetworkAclDenyAllowGroupIpList", reqParams)
@@ -254,3 +306,139 @@ func setNetworkAclDenyAllowGroupIpList(d *schema.ResourceData, config *conn.Prov
 
 	return nil
 }
+
+// networkAclDenyAllowGroupMaxIps is the number of entries a Deny-Allow Group accepts.
+const networkAclDenyAllowGroupMaxIps = 100
+
+type networkAclDenyAllowGroupIpListGetter interface {
+	Get(key string) interface{}
+}
+
+func expandNetworkAclDenyAllowGroupIpList(d networkAclDenyAllowGroupIpListGetter) ([]string, error) {
+	if content := d.Get("ip_list_content").(string); len(content) > 0 {
+		return parseNetworkAclDenyAllowGroupIpListContent(content)
+	}
+
+	return normalizeNetworkAclDenyAllowGroupIpList(ncloud.StringListValue(ExpandStringSet(d.Get("ip_list").(*schema.Set)))), nil
+}
+
+// parseNetworkAclDenyAllowGroupIpListContent reads one IPv4 address or CIDR block per line, skipping blank lines and `#` comments.
+// The entries are returned sorted and without duplicates.
+func parseNetworkAclDenyAllowGroupIpListContent(content string) ([]string, error) {
+	var ips []string
+
+	for i, line := range strings.Split(content, "\n") {
+		line, _, _ = strings.Cut(line, "#")
+		line = strings.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+
+		ip := net.ParseIP(line)
+		if ip == nil {
+			cidrIp, _, err := net.ParseCIDR(line)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %q is not an IP address or a CIDR block", i+1, line)
+			}
+			ip = cidrIp
+		}
+
+		if ip.To4() == nil {
+			return nil, fmt.Errorf("line %d: %q is not IPv4, which is the only version a Deny-Allow Group accepts", i+1, line)
+		}
+
+		ips = append(ips, line)
+	}
+
+	return normalizeNetworkAclDenyAllowGroupIpList(ips), nil
+}
+
+func normalizeNetworkAclDenyAllowGroupIpList(ips []string) []string {
+	seen := make(map[string]bool, len(ips))
+	normalized := make([]string, 0, len(ips))
+
+	for _, ip := range ips {
+		if !seen[ip] {
+			seen[ip] = true
+			normalized = append(normalized, ip)
+		}
+	}
+
+	sort.Strings(normalized)
+
+	return normalized
+}
+
+func validateNetworkAclDenyAllowGroupIpListContent(i interface{}, k string) ([]string, []error) {
+	ips, err := parseNetworkAclDenyAllowGroupIpListContent(i.(string))
+	if err != nil {
+		return nil, []error{fmt.Errorf("%s: %s", k, err)}
+	}
+
+	if len(ips) > networkAclDenyAllowGroupMaxIps {
+		return nil, []error{fmt.Errorf("%s: a Deny-Allow Group holds at most %d entries, got %d. "+
+			"Aggregate the IPs into CIDR blocks or split them across several Deny-Allow Groups", k, networkAclDenyAllowGroupMaxIps, len(ips))}
+	}
+
+	return nil, nil
+}
+
+// diffNetworkAclDenyAllowGroupIpList returns the number of entries of newIps missing from oldIps, and the other way around.
+func diffNetworkAclDenyAllowGroupIpList(oldIps, newIps []string) (added int, removed int) {
+	oldSet := make(map[string]bool, len(oldIps))
+	for _, ip := range oldIps {
+		oldSet[ip] = true
+	}
+
+	newSet := make(map[string]bool, len(newIps))
+	for _, ip := range newIps {
+		newSet[ip] = true
+		if !oldSet[ip] {
+			added++
+		}
+	}
+
+	for _, ip := range oldIps {
+		if !newSet[ip] {
+			removed++
+		}
+	}
+
+	return added, removed
+}
+
+// resourceNcloudNetworkACLDenyAllowGroupCustomizeDiff plans `ip_count`, `ip_list_added_count` and `ip_list_removed_count`,
+// so that the size of a change to a large IP list shows up in the plan.
+func resourceNcloudNetworkACLDenyAllowGroupCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChanges("ip_list", "ip_list_content") || !d.NewValueKnown("ip_list") || !d.NewValueKnown("ip_list_content") {
+		return nil
+	}
+
+	newIps, err := expandNetworkAclDenyAllowGroupIpList(d)
+	if err != nil {
+		return err
+	}
+
+	var oldIps []string
+	if d.Id() != "" {
+		oldIpList, _ := d.GetChange("ip_list")
+		oldContent, _ := d.GetChange("ip_list_content")
+		if len(oldContent.(string)) > 0 {
+			oldIps, _ = parseNetworkAclDenyAllowGroupIpListContent(oldContent.(string))
+		} else {
+			oldIps = normalizeNetworkAclDenyAllowGroupIpList(ncloud.StringListValue(ExpandStringSet(oldIpList.(*schema.Set))))
+		}
+	}
+
+	added, removed := diffNetworkAclDenyAllowGroupIpList(oldIps, newIps)
+
+	if err := d.SetNew("ip_count", len(newIps)); err != nil {
+		return err
+	}
+
+	if err := d.SetNew("ip_list_added_count", added); err != nil {
+		return err
+	}
+
+	return d.SetNew("ip_list_removed_count", removed)
+}
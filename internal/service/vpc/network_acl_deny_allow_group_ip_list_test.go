@@ -0,0 +1,71 @@
+package vpc
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseNetworkAclDenyAllowGroupIpListContent(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected []string
+		Error    bool
+	}{
+		{Name: "empty", Content: "", Expected: []string{}},
+		{Name: "ips and cidrs", Content: "10.0.0.2\n10.0.0.1\n192.168.0.0/24\n", Expected: []string{"10.0.0.1", "10.0.0.2", "192.168.0.0/24"}},
+		{Name: "comments and blank lines", Content: "# feed\n\n10.0.0.1 # first\n  10.0.0.2  \r\n", Expected: []string{"10.0.0.1", "10.0.0.2"}},
+		{Name: "duplicates", Content: "10.0.0.1\n10.0.0.1\n", Expected: []string{"10.0.0.1"}},
+		{Name: "invalid entry", Content: "10.0.0.1\nexample.com\n", Error: true},
+		{Name: "ipv6 address", Content: "10.0.0.1\n2001:db8::1\n", Error: true},
+		{Name: "ipv6 cidr", Content: "2001:db8::/32\n", Error: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ips, err := parseNetworkAclDenyAllowGroupIpListContent(tc.Content)
+			if tc.Error {
+				if err == nil {
+					t.Fatalf("expected error, got %v", ips)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(ips, tc.Expected) {
+				t.Fatalf("expected %v, got %v", tc.Expected, ips)
+			}
+		})
+	}
+}
+
+func TestValidateNetworkAclDenyAllowGroupIpListContent(t *testing.T) {
+	var lines []string
+	for i := 0; i <= networkAclDenyAllowGroupMaxIps; i++ {
+		lines = append(lines, fmt.Sprintf("10.0.%d.%d", i/256, i%256))
+	}
+
+	if _, errs := validateNetworkAclDenyAllowGroupIpListContent(strings.Join(lines[:networkAclDenyAllowGroupMaxIps], "\n"), "ip_list_content"); len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+
+	if _, errs := validateNetworkAclDenyAllowGroupIpListContent(strings.Join(lines, "\n"), "ip_list_content"); len(errs) == 0 {
+		t.Fatalf("expected an error for %d entries", len(lines))
+	}
+}
+
+func TestDiffNetworkAclDenyAllowGroupIpList(t *testing.T) {
+	added, removed := diffNetworkAclDenyAllowGroupIpList(
+		[]string{"10.0.0.1", "10.0.0.2", "10.0.0.3"},
+		[]string{"10.0.0.2", "10.0.0.3", "10.0.0.4", "10.0.0.5"},
+	)
+
+	if added != 2 || removed != 1 {
+		t.Fatalf("expected 2 added and 1 removed, got %d added and %d removed", added, removed)
+	}
+}
@@ -37,9 +37,10 @@ func TestAccResourceNcloudNetworkACLDenyAllowGroup_basic(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ip_list_added_count", "ip_list_removed_count"},
 			},
 		},
 	})
@@ -92,9 +93,54 @@ func TestAccResourceNcloudNetworkACLDenyAllowGroup_update(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ip_list_added_count", "ip_list_removed_count"},
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudNetworkACLDenyAllowGroup_ipListContent(t *testing.T) {
+	var networkAclDenyAllowGroup vpc.NetworkAclDenyAllowGroup
+	name := fmt.Sprintf("tf-nacl-allow-content-%s", acctest.RandString(5))
+	resourceName := "ncloud_network_acl_deny_allow_group.this"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkACLDenyAllowGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudNetworkACLDenyAllowGroupConfigIpListContent(name, "# blocklist\n10.0.0.1\n10.0.0.2\n10.0.0.3\n"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkACLDenyAllowGroupExists(resourceName, &networkAclDenyAllowGroup),
+					resource.TestCheckResourceAttr(resourceName, "ip_count", "3"),
+					resource.TestCheckResourceAttr(resourceName, "ip_list_added_count", "3"),
+					resource.TestCheckResourceAttr(resourceName, "ip_list_removed_count", "0"),
+					resource.TestCheckNoResourceAttr(resourceName, "ip_list.#"),
+				),
+			},
+			{
+				// Reordering and commenting the content doesn't change the IP list.
+				Config:   testAccResourceNcloudNetworkACLDenyAllowGroupConfigIpListContent(name, "10.0.0.3\n10.0.0.2 # second\n10.0.0.1\n"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccResourceNcloudNetworkACLDenyAllowGroupConfigIpListContent(name, "10.0.0.2\n10.0.0.3\n10.0.0.4\n10.0.0.5\n"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkACLDenyAllowGroupExists(resourceName, &networkAclDenyAllowGroup),
+					resource.TestCheckResourceAttr(resourceName, "ip_count", "4"),
+					resource.TestCheckResourceAttr(resourceName, "ip_list_added_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ip_list_removed_count", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ip_list", "ip_list_content", "ip_list_added_count", "ip_list_removed_count"},
 			},
 		},
 	})
@@ -125,9 +171,10 @@ func TestAccResourceNcloudNetworkACLDenyAllowGroup_description(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ip_list_added_count", "ip_list_removed_count"},
 			},
 		},
 	})
@@ -169,6 +216,21 @@ resource "ncloud_network_acl_deny_allow_group" "this" {
 `, name)
 }
 
+func testAccResourceNcloudNetworkACLDenyAllowGroupConfigIpListContent(name, content string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "vpc" {
+	name            = "%[1]s"
+	ipv4_cidr_block = "10.3.0.0/16"
+}
+
+resource "ncloud_network_acl_deny_allow_group" "this" {
+	vpc_no          = ncloud_vpc.vpc.vpc_no
+	name            = "%[1]s"
+	ip_list_content = %[2]q
+}
+`, name, content)
+}
+
 func testAccCheckNetworkACLDenyAllowGroupExists(n string, networkACL *vpc.NetworkAclDenyAllowGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
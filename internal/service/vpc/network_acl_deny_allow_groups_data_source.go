@@ -34,12 +34,21 @@ func DataSourceNcloudNetworkACLDenyAllowGroups() *schema.Resource {
 			"network_acl_deny_allow_groups": {
 				Type:     schema.TypeList,
 				Computed: true,
-				Elem:     GetDataSourceItemSchema(ResourceNcloudNetworkACLDenyAllowGroup()),
+				Elem:     GetDataSourceItemSchema(networkACLDenyAllowGroupDataSourceResourceSchema()),
 			},
 		},
 	}
 }
 
+// networkACLDenyAllowGroupDataSourceResourceSchema is the resource schema without the attributes that only describe a planned change.
+func networkACLDenyAllowGroupDataSourceResourceSchema() *schema.Resource {
+	r := ResourceNcloudNetworkACLDenyAllowGroup()
+	delete(r.Schema, "ip_list_added_count")
+	delete(r.Schema, "ip_list_removed_count")
+
+	return r
+}
+
 func dataSourceNcloudNetworkACLDenyAllowGroupsRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*conn.ProviderConfig)
 
@@ -88,6 +97,7 @@ func dataSourceNcloudNetworkACLDenyAllowGroupsRead(d *schema.ResourceData, meta
 			return err
 		} else {
 			m["ip_list"] = g.IpList
+			m["ip_count"] = len(g.IpList)
 		}
 
 		resources = append(resources, m)
@@ -0,0 +1,270 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
+)
+
+const (
+	networkACLRulePriorityMin = 0
+	networkACLRulePriorityMax = 199
+)
+
+// ResourceNcloudNetworkACLEntry manages a single inbound or outbound rule of a Network ACL.
+// The ID is composed of `{network_acl_no}:{rule_type}:{priority}`, since a priority is unique per direction.
+func ResourceNcloudNetworkACLEntry() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNcloudNetworkACLEntryCreate,
+		Read:   resourceNcloudNetworkACLEntryRead,
+		Update: resourceNcloudNetworkACLEntryUpdate,
+		Delete: resourceNcloudNetworkACLEntryDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNcloudNetworkACLEntryImport,
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if err := checkNetworkACLEntryPriorityRange(d); err != nil {
+				return err
+			}
+
+			return checkNetworkACLEntryPriorityInUse(d, meta.(*conn.ProviderConfig))
+		},
+		Schema: map[string]*schema.Schema{
+			"network_acl_no": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rule_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"inbound", "outbound"}, false)),
+			},
+			"priority": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(networkACLRulePriorityMin, networkACLRulePriorityMax)),
+				Description:      "Priority of the rule. When omitted, the lowest free priority within `priority_range_min` and `priority_range_max` is assigned.",
+			},
+			"priority_range_min": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          networkACLRulePriorityMin,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(networkACLRulePriorityMin, networkACLRulePriorityMax)),
+			},
+			"priority_range_max": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          networkACLRulePriorityMax,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(networkACLRulePriorityMin, networkACLRulePriorityMax)),
+			},
+			"protocol": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"TCP", "UDP", "ICMP"}, false)),
+			},
+			"ip_block": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsCIDRNetwork(0, 32)),
+				ExactlyOneOf:     []string{"ip_block", "deny_allow_group_no"},
+			},
+			"deny_allow_group_no": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"ip_block", "deny_allow_group_no"},
+			},
+			"rule_action": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"ALLOW", "DROP"}, false)),
+			},
+			"port_range": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(ValidatePortRange),
+				Default:          "",
+			},
+			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(0, 1000)),
+				Default:          "",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(conn.DefaultCreateTimeout),
+			Delete: schema.DefaultTimeout(conn.DefaultTimeout),
+		},
+	}
+}
+
+func resourceNcloudNetworkACLEntryCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+	networkACLNo := d.Get("network_acl_no").(string)
+	ruleType := d.Get("rule_type").(string)
+
+	// The lock is held until the rule is added, so entries created in parallel see each other's priorities
+	unlock := networkACLLocks.Lock(networkACLNo)
+	defer unlock()
+
+	rules, err := GetNetworkACLRuleList(config, networkACLNo)
+	if err != nil {
+		return err
+	}
+
+	if d.GetRawConfig().GetAttr("priority").IsNull() {
+		var used []int
+		for _, r := range rules {
+			if networkACLRuleTypeOf(r) == ruleType {
+				used = append(used, int(ncloud.Int32Value(r.Priority)))
+			}
+		}
+
+		priority, err := allocateNetworkACLRulePriority(d.Get("priority_range_min").(int), d.Get("priority_range_max").(int), used)
+		if err != nil {
+			return fmt.Errorf("network ACL (%s) %s rule: %s", networkACLNo, ruleType, err)
+		}
+
+		log.Printf("[INFO] Assigned priority %d to Network ACL (%s) %s rule", priority, networkACLNo, ruleType)
+		d.Set("priority", priority)
+	} else if err := networkACLRulePriorityInUse(networkACLNo, ruleType, d.Get("priority").(int), rules); err != nil {
+		return err
+	}
+
+	m := networkACLEntryMap(d)
+	if err := addNetworkACLRuleLocked(d, config, networkACLNo, ruleType, expandAddNetworkAclRule([]interface{}{m})); err != nil {
+		return err
+	}
+
+	d.SetId(networkACLEntryId(networkACLNo, ruleType, m["priority"].(int)))
+	log.Printf("[INFO] Network ACL %s rule ID: %s", ruleType, d.Id())
+
+	return resourceNcloudNetworkACLEntryRead(d, meta)
+}
+
+func resourceNcloudNetworkACLEntryRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+	ruleType := d.Get("rule_type").(string)
+
+	rules, err := GetNetworkACLRuleList(config, d.Get("network_acl_no").(string))
+	if err != nil {
+		errBody, _ := GetCommonErrorBody(err)
+		if errBody.ReturnCode == ApiErrorNetworkAclCantAccessaApropriate {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	var rule *vpc.NetworkAclRule
+	for _, r := range rules {
+		if networkACLRuleTypeOf(r) == ruleType && int(ncloud.Int32Value(r.Priority)) == d.Get("priority").(int) {
+			rule = r
+			break
+		}
+	}
+
+	if rule == nil {
+		log.Printf("[WARN] Network ACL %s rule (%s) not found, removing from state", ruleType, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("network_acl_no", rule.NetworkAclNo)
+	d.Set("priority", int(ncloud.Int32Value(rule.Priority)))
+	d.Set("protocol", rule.ProtocolType.Code)
+	d.Set("port_range", rule.PortRange)
+	d.Set("rule_action", rule.RuleAction.Code)
+	d.Set("ip_block", rule.IpBlock)
+	d.Set("deny_allow_group_no", rule.DenyAllowGroupNo)
+	d.Set("description", rule.NetworkAclRuleDescription)
+
+	return nil
+}
+
+// resourceNcloudNetworkACLEntryUpdate only stores the priority range, which is used when the rule is created.
+func resourceNcloudNetworkACLEntryUpdate(d *schema.ResourceData, meta interface{}) error {
+	return resourceNcloudNetworkACLEntryRead(d, meta)
+}
+
+func resourceNcloudNetworkACLEntryDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*conn.ProviderConfig)
+	networkACLNo := d.Get("network_acl_no").(string)
+
+	_ = waitForNcloudNetworkACLRunning(config, networkACLNo)
+
+	return removeNetworkACLRule(d, config, networkACLNo, d.Get("rule_type").(string), expandRemoveNetworkAclRule([]interface{}{networkACLEntryMap(d)}))
+}
+
+func resourceNcloudNetworkACLEntryImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	networkACLNo, ruleType, priority, err := parseNetworkACLEntryId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("network_acl_no", networkACLNo)
+	d.Set("rule_type", ruleType)
+	d.Set("priority", priority)
+	d.Set("priority_range_min", networkACLRulePriorityMin)
+	d.Set("priority_range_max", networkACLRulePriorityMax)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func networkACLEntryMap(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"priority":            d.Get("priority").(int),
+		"protocol":            d.Get("protocol").(string),
+		"ip_block":            d.Get("ip_block").(string),
+		"deny_allow_group_no": d.Get("deny_allow_group_no").(string),
+		"rule_action":         d.Get("rule_action").(string),
+		"port_range":          d.Get("port_range").(string),
+		"description":         d.Get("description").(string),
+	}
+}
+
+func networkACLEntryId(networkACLNo, ruleType string, priority int) string {
+	return fmt.Sprintf("%s:%s:%d", networkACLNo, ruleType, priority)
+}
+
+func parseNetworkACLEntryId(id string) (string, string, int, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 3 || parts[0] == "" || (parts[1] != "inbound" && parts[1] != "outbound") {
+		return "", "", 0, fmt.Errorf("unexpected format of ID (%s), expected network_acl_no:rule_type:priority", id)
+	}
+
+	priority, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return "", "", 0, fmt.Errorf("unexpected format of ID (%s), priority must be a number", id)
+	}
+
+	return parts[0], parts[1], priority, nil
+}
+
+func networkACLRuleTypeOf(r *vpc.NetworkAclRule) string {
+	if ncloud.StringValue(r.NetworkAclRuleType.Code) == "INBND" {
+		return "inbound"
+	}
+	return "outbound"
+}
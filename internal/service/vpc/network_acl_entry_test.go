@@ -0,0 +1,157 @@
+package vpc_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+)
+
+func TestAccResourceNcloudNetworkACLEntry_basic(t *testing.T) {
+	name := fmt.Sprintf("test-nacl-entry-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudNetworkACLEntryConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ncloud_network_acl_entry.ssh", "priority", "100"),
+					resource.TestCheckResourceAttr("ncloud_network_acl_entry.http", "priority", "101"),
+					resource.TestCheckResourceAttr("ncloud_network_acl_entry.egress", "priority", "100"),
+					resource.TestMatchResourceAttr("ncloud_network_acl_entry.ssh", "id", regexp.MustCompile(`^\d+:inbound:100$`)),
+				),
+			},
+			{
+				ResourceName:            "ncloud_network_acl_entry.http",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"priority_range_min", "priority_range_max"},
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudNetworkACLEntry_priorityCollision(t *testing.T) {
+	name := fmt.Sprintf("test-nacl-entry-dup-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceNcloudNetworkACLEntryConfigCollision(name),
+				ExpectError: regexp.MustCompile("is already used"),
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudNetworkACLEntry_priorityCollisionAtPlan(t *testing.T) {
+	name := fmt.Sprintf("test-nacl-entry-plan-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudNetworkACLEntryConfigSsh(name),
+			},
+			{
+				Config:      testAccResourceNcloudNetworkACLEntryConfigCollision(name),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`priority 100 of the inbound rules of network ACL \(\d+\) is already used`),
+			},
+		},
+	})
+}
+
+func testAccResourceNcloudNetworkACLEntryConfig(name string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "vpc" {
+	name            = "%[1]s"
+	ipv4_cidr_block = "10.3.0.0/16"
+}
+
+resource "ncloud_network_acl" "nacl" {
+	vpc_no = ncloud_vpc.vpc.id
+	name   = "%[1]s"
+}
+
+resource "ncloud_network_acl_entry" "ssh" {
+	network_acl_no = ncloud_network_acl.nacl.id
+	rule_type      = "inbound"
+	priority       = 100
+	protocol       = "TCP"
+	rule_action    = "ALLOW"
+	ip_block       = "10.0.0.0/8"
+	port_range     = "22"
+}
+
+resource "ncloud_network_acl_entry" "http" {
+	network_acl_no     = ncloud_network_acl.nacl.id
+	rule_type          = "inbound"
+	priority_range_min = 100
+	priority_range_max = 149
+	protocol           = "TCP"
+	rule_action        = "ALLOW"
+	ip_block           = "0.0.0.0/0"
+	port_range         = "80"
+
+	depends_on = [ncloud_network_acl_entry.ssh]
+}
+
+resource "ncloud_network_acl_entry" "egress" {
+	network_acl_no     = ncloud_network_acl.nacl.id
+	rule_type          = "outbound"
+	priority_range_min = 100
+	protocol           = "TCP"
+	rule_action        = "ALLOW"
+	ip_block           = "0.0.0.0/0"
+	port_range         = "1-65535"
+}
+`, name)
+}
+
+func testAccResourceNcloudNetworkACLEntryConfigSsh(name string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "vpc" {
+	name            = "%[1]s"
+	ipv4_cidr_block = "10.3.0.0/16"
+}
+
+resource "ncloud_network_acl" "nacl" {
+	vpc_no = ncloud_vpc.vpc.id
+	name   = "%[1]s"
+}
+
+resource "ncloud_network_acl_entry" "ssh" {
+	network_acl_no = ncloud_network_acl.nacl.id
+	rule_type      = "inbound"
+	priority       = 100
+	protocol       = "TCP"
+	rule_action    = "ALLOW"
+	ip_block       = "10.0.0.0/8"
+	port_range     = "22"
+}
+`, name)
+}
+
+func testAccResourceNcloudNetworkACLEntryConfigCollision(name string) string {
+	return testAccResourceNcloudNetworkACLEntryConfigSsh(name) + `
+resource "ncloud_network_acl_entry" "http" {
+	network_acl_no = ncloud_network_acl.nacl.id
+	rule_type      = "inbound"
+	priority       = 100
+	protocol       = "TCP"
+	rule_action    = "ALLOW"
+	ip_block       = "0.0.0.0/0"
+	port_range     = "80"
+}
+`
+}
@@ -0,0 +1,87 @@
+package vpc
+
+import (
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+// allocateNetworkACLRulePriority returns the lowest priority within [min, max] that is not used.
+func allocateNetworkACLRulePriority(min, max int, used []int) (int, error) {
+	if min > max {
+		return 0, fmt.Errorf("priority_range_min (%d) is greater than priority_range_max (%d)", min, max)
+	}
+
+	taken := map[int]bool{}
+	for _, p := range used {
+		taken[p] = true
+	}
+
+	for p := min; p <= max; p++ {
+		if !taken[p] {
+			return p, nil
+		}
+	}
+
+	return 0, fmt.Errorf("no free priority left between %d and %d", min, max)
+}
+
+// networkACLLocks serializes rule changes per Network ACL within the provider process,
+// so automatically assigned priorities do not collide and changes do not hit ApiErrorNetworkAclRuleChangeIngRules.
+// addNetworkACLRule and removeNetworkACLRule take it, so every resource that changes rules is serialized.
+var networkACLLocks KeyedMutex
+
+// networkACLRulePriorityInUse returns an error when a rule of rules already has ruleType and priority.
+func networkACLRulePriorityInUse(networkACLNo, ruleType string, priority int, rules []*vpc.NetworkAclRule) error {
+	for _, r := range rules {
+		if networkACLRuleTypeOf(r) == ruleType && int(ncloud.Int32Value(r.Priority)) == priority {
+			return fmt.Errorf("priority %d of the %s rules of network ACL (%s) is already used", priority, ruleType, networkACLNo)
+		}
+	}
+
+	return nil
+}
+
+// checkNetworkACLEntryPriorityInUse reports at plan time an explicit priority that an existing rule of the Network ACL already uses.
+// Entries created in the same apply don't exist yet, so their collisions are only reported by Create.
+func checkNetworkACLEntryPriorityInUse(d *schema.ResourceDiff, config *conn.ProviderConfig) error {
+	if d.GetRawConfig().GetAttr("priority").IsNull() {
+		return nil
+	}
+
+	for _, k := range []string{"network_acl_no", "rule_type", "priority"} {
+		if !d.NewValueKnown(k) {
+			return nil
+		}
+	}
+
+	// An existing entry keeps its rule unless the slot it occupies moves
+	if d.Id() != "" && !d.HasChanges("network_acl_no", "rule_type", "priority") {
+		return nil
+	}
+
+	networkACLNo := d.Get("network_acl_no").(string)
+	rules, err := GetNetworkACLRuleList(config, networkACLNo)
+	if err != nil {
+		errBody, _ := GetCommonErrorBody(err)
+		if errBody.ReturnCode == ApiErrorNetworkAclCantAccessaApropriate {
+			return nil
+		}
+		return err
+	}
+
+	return networkACLRulePriorityInUse(networkACLNo, d.Get("rule_type").(string), d.Get("priority").(int), rules)
+}
+
+func checkNetworkACLEntryPriorityRange(d *schema.ResourceDiff) error {
+	if d.Get("priority_range_min").(int) > d.Get("priority_range_max").(int) {
+		return fmt.Errorf("priority_range_min (%d) is greater than priority_range_max (%d)", d.Get("priority_range_min"), d.Get("priority_range_max"))
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package vpc
+
+import (
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+)
+
+func TestAllocateNetworkACLRulePriority(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Min, Max int
+		Used     []int
+		Expected int
+		Error    bool
+	}{
+		{Name: "empty", Min: 0, Max: 199, Expected: 0},
+		{Name: "skips used", Min: 0, Max: 199, Used: []int{0, 1, 3}, Expected: 2},
+		{Name: "range", Min: 100, Max: 110, Used: []int{0, 100}, Expected: 101},
+		{Name: "full", Min: 10, Max: 11, Used: []int{10, 11}, Error: true},
+		{Name: "inverted range", Min: 11, Max: 10, Error: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			priority, err := allocateNetworkACLRulePriority(tc.Min, tc.Max, tc.Used)
+			if tc.Error {
+				if err == nil {
+					t.Fatalf("expected an error, got %d", priority)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if priority != tc.Expected {
+				t.Fatalf("expected %d, got %d", tc.Expected, priority)
+			}
+		})
+	}
+}
+
+func TestNetworkACLRulePriorityInUse(t *testing.T) {
+	rules := []*vpc.NetworkAclRule{
+		{Priority: ncloud.Int32(100), NetworkAclRuleType: &vpc.CommonCode{Code: ncloud.String("INBND")}},
+		{Priority: ncloud.Int32(110), NetworkAclRuleType: &vpc.CommonCode{Code: ncloud.String("OTBND")}},
+	}
+
+	if err := networkACLRulePriorityInUse("1234", "inbound", 100, rules); err == nil {
+		t.Fatal("expected a collision error")
+	}
+
+	if err := networkACLRulePriorityInUse("1234", "outbound", 100, rules); err != nil {
+		t.Fatalf("unexpected error for another direction: %s", err)
+	}
+
+	if err := networkACLRulePriorityInUse("1234", "inbound", 110, rules); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestParseNetworkACLEntryId(t *testing.T) {
+	networkACLNo, ruleType, priority, err := parseNetworkACLEntryId(networkACLEntryId("1234", "outbound", 42))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if networkACLNo != "1234" || ruleType != "outbound" || priority != 42 {
+		t.Fatalf("unexpected result: %s %s %d", networkACLNo, ruleType, priority)
+	}
+
+	for _, id := range []string{"1234", "1234:sideways:1", "1234:inbound:x", ":inbound:1"} {
+		if _, _, _, err := parseNetworkACLEntryId(id); err == nil {
+			t.Fatalf("expected an error for %q", id)
+		}
+	}
+}
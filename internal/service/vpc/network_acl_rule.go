@@ -149,9 +149,24 @@ func resourceNcloudNetworkACLRuleRead(d *schema.ResourceData, meta interface{})
 
 	d.Set("network_acl_no", d.Id())
 
-	// Create empty set for getNetworkACLRuleList
-	iSet := schema.NewSet(schema.HashResource(ResourceNcloudNetworkACLRule().Schema["inbound"].Elem.(*schema.Resource)), []interface{}{})
-	oSet := schema.NewSet(schema.HashResource(ResourceNcloudNetworkACLRule().Schema["outbound"].Elem.(*schema.Resource)), []interface{}{})
+	iSet, oSet := flattenNetworkACLRules(rules)
+
+	// Only set data intersection between resource and list
+	if err := d.Set("inbound", iSet.List()); err != nil {
+		log.Printf("[WARN] Error setting inbound rule set for (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("outbound", oSet.List()); err != nil {
+		log.Printf("[WARN] Error setting outbound rule set for (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// flattenNetworkACLRules splits rules into inbound and outbound sets hashed like the `inbound`/`outbound` blocks.
+func flattenNetworkACLRules(rules []*vpc.NetworkAclRule) (*schema.Set, *schema.Set) {
+	iSet := schema.NewSet(networkACLRuleHash, []interface{}{})
+	oSet := schema.NewSet(networkACLRuleHash, []interface{}{})
 
 	for _, r := range rules {
 		m := map[string]interface{}{
@@ -171,16 +186,11 @@ func resourceNcloudNetworkACLRuleRead(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	// Only set data intersection between resource and list
-	if err := d.Set("inbound", iSet.List()); err != nil {
-		log.Printf("[WARN] Error setting inbound rule set for (%s): %s", d.Id(), err)
-	}
-
-	if err := d.Set("outbound", oSet.List()); err != nil {
-		log.Printf("[WARN] Error setting outbound rule set for (%s): %s", d.Id(), err)
-	}
+	return iSet, oSet
+}
 
-	return nil
+func networkACLRuleHash(v interface{}) int {
+	return schema.HashResource(ResourceNcloudNetworkACLRule().Schema["inbound"].Elem.(*schema.Resource))(v)
 }
 
 func resourceNcloudNetworkACLRuleUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -210,13 +220,13 @@ func resourceNcloudNetworkACLRuleDelete(d *schema.ResourceData, meta interface{}
 	_ = waitForNcloudNetworkACLRunning(config, d.Id())
 
 	if len(i.List()) > 0 {
-		if err := removeNetworkACLRule(d, config, "inbound", expandRemoveNetworkAclRule(i.List())); err != nil {
+		if err := removeNetworkACLRule(d, config, d.Id(), "inbound", expandRemoveNetworkAclRule(i.List())); err != nil {
 			return err
 		}
 	}
 
 	if len(o.List()) > 0 {
-		if err := removeNetworkACLRule(d, config, "outbound", expandRemoveNetworkAclRule(o.List())); err != nil {
+		if err := removeNetworkACLRule(d, config, d.Id(), "outbound", expandRemoveNetworkAclRule(o.List())); err != nil {
 			return err
 		}
 	}
@@ -281,13 +291,13 @@ func updateNetworkACLRule(d *schema.ResourceData, config *conn.ProviderConfig, r
 	addNetworkACLRuleList := expandAddNetworkAclRule(add)
 
 	if len(removeNetworkACLRuleList) > 0 {
-		if err := removeNetworkACLRule(d, config, ruleType, removeNetworkACLRuleList); err != nil {
+		if err := removeNetworkACLRule(d, config, d.Id(), ruleType, removeNetworkACLRuleList); err != nil {
 			return err
 		}
 	}
 
 	if len(addNetworkACLRuleList) > 0 {
-		if err := addNetworkACLRule(d, config, ruleType, addNetworkACLRuleList); err != nil {
+		if err := addNetworkACLRule(d, config, d.Id(), ruleType, addNetworkACLRuleList); err != nil {
 			return err
 		}
 	}
@@ -295,7 +305,15 @@ func updateNetworkACLRule(d *schema.ResourceData, config *conn.ProviderConfig, r
 	return nil
 }
 
-func addNetworkACLRule(d *schema.ResourceData, config *conn.ProviderConfig, ruleType string, addNetworkRuleList []*vpc.AddNetworkAclRuleParameter) error {
+func addNetworkACLRule(d *schema.ResourceData, config *conn.ProviderConfig, networkACLNo string, ruleType string, addNetworkRuleList []*vpc.AddNetworkAclRuleParameter) error {
+	unlock := networkACLLocks.Lock(networkACLNo)
+	defer unlock()
+
+	return addNetworkACLRuleLocked(d, config, networkACLNo, ruleType, addNetworkRuleList)
+}
+
+// addNetworkACLRuleLocked is addNetworkACLRule for callers that already hold the lock of networkACLNo.
+func addNetworkACLRuleLocked(d *schema.ResourceData, config *conn.ProviderConfig, networkACLNo string, ruleType string, addNetworkRuleList []*vpc.AddNetworkAclRuleParameter) error {
 	var reqParams interface{}
 	var resp interface{}
 
@@ -305,7 +323,7 @@ func addNetworkACLRule(d *schema.ResourceData, config *conn.ProviderConfig, rule
 		if ruleType == "inbound" {
 			reqParams = &vpc.AddNetworkAclInboundRuleRequest{
 				RegionCode:         &config.RegionCode,
-				NetworkAclNo:       ncloud.String(d.Id()),
+				NetworkAclNo:       ncloud.String(networkACLNo),
 				NetworkAclRuleList: addNetworkRuleList,
 			}
 
@@ -314,7 +332,7 @@ func addNetworkACLRule(d *schema.ResourceData, config *conn.ProviderConfig, rule
 		} else {
 			reqParams = &vpc.AddNetworkAclOutboundRuleRequest{
 				RegionCode:         &config.RegionCode,
-				NetworkAclNo:       ncloud.String(d.Id()),
+				NetworkAclNo:       ncloud.String(networkACLNo),
 				NetworkAclRuleList: addNetworkRuleList,
 			}
 
@@ -341,14 +359,17 @@ func addNetworkACLRule(d *schema.ResourceData, config *conn.ProviderConfig, rule
 
 	LogResponse("AddNetworkAclRule", resp)
 
-	if err = waitForNcloudNetworkACLRunning(config, d.Id()); err != nil {
+	if err = waitForNcloudNetworkACLRunning(config, networkACLNo); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func removeNetworkACLRule(d *schema.ResourceData, config *conn.ProviderConfig, ruleType string, removeNetworkRuleList []*vpc.RemoveNetworkAclRuleParameter) error {
+func removeNetworkACLRule(d *schema.ResourceData, config *conn.ProviderConfig, networkACLNo string, ruleType string, removeNetworkRuleList []*vpc.RemoveNetworkAclRuleParameter) error {
+	unlock := networkACLLocks.Lock(networkACLNo)
+	defer unlock()
+
 	var reqParams interface{}
 	var resp interface{}
 
@@ -358,7 +379,7 @@ func removeNetworkACLRule(d *schema.ResourceData, config *conn.ProviderConfig, r
 		if ruleType == "inbound" {
 			reqParams = &vpc.RemoveNetworkAclInboundRuleRequest{
 				RegionCode:         &config.RegionCode,
-				NetworkAclNo:       ncloud.String(d.Id()),
+				NetworkAclNo:       ncloud.String(networkACLNo),
 				NetworkAclRuleList: removeNetworkRuleList,
 			}
 
@@ -367,7 +388,7 @@ func removeNetworkACLRule(d *schema.ResourceData, config *conn.ProviderConfig, r
 		} else {
 			reqParams = &vpc.RemoveNetworkAclOutboundRuleRequest{
 				RegionCode:         &config.RegionCode,
-				NetworkAclNo:       ncloud.String(d.Id()),
+				NetworkAclNo:       ncloud.String(networkACLNo),
 				NetworkAclRuleList: removeNetworkRuleList,
 			}
 
@@ -394,7 +415,7 @@ func removeNetworkACLRule(d *schema.ResourceData, config *conn.ProviderConfig, r
 
 	LogResponse("RemoveNetworkAclRule", resp)
 
-	if err = waitForNcloudNetworkACLRunning(config, d.Id()); err != nil {
+	if err = waitForNcloudNetworkACLRunning(config, networkACLNo); err != nil {
 		return err
 	}
 
@@ -2,6 +2,7 @@ package vpc
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -38,6 +39,12 @@ func ResourceNcloudRoute() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if !d.HasChanges("target_type", "target_no", "destination_cidr_block") || !d.NewValueKnown("target_no") || !d.NewValueKnown("destination_cidr_block") {
+				return nil
+			}
+			return checkNatGatewayRouteTarget(ctx, meta.(*conn.ProviderConfig), d.Get("target_type").(string), d.Get("target_no").(string), d.Get("destination_cidr_block").(string))
+		},
 		Schema: map[string]*schema.Schema{
 			"route_table_no": {
 				Type:     schema.TypeString,
@@ -89,54 +96,14 @@ func resourceNcloudRouteCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("No matching route table: %s", d.Get("route_table_no"))
 	}
 
-	routeParams := &vpc.RouteParameter{
-		DestinationCidrBlock: ncloud.String(d.Get("destination_cidr_block").(string)),
-		TargetTypeCode:       ncloud.String(d.Get("target_type").(string)),
-		TargetName:           ncloud.String(d.Get("target_name").(string)),
-		TargetNo:             ncloud.String(d.Get("target_no").(string)),
-	}
-
-	reqParams := &vpc.AddRouteRequest{
-		RegionCode:   &config.RegionCode,
-		VpcNo:        ncloud.String(*routeTable.VpcNo),
-		RouteTableNo: ncloud.String(d.Get("route_table_no").(string)),
-		RouteList:    []*vpc.RouteParameter{routeParams},
-	}
-
-	var resp *vpc.AddRouteResponse
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		var err error
-
-		LogCommonRequest("AddRoute", reqParams)
-		resp, err = config.Client.Vpc.V2Api.AddRoute(reqParams)
-
-		if err != nil {
-			errBody, _ := GetCommonErrorBody(err)
-			if errBody.ReturnCode == "1017013" {
-				LogErrorResponse("retry add Route", err, reqParams)
-				time.Sleep(time.Second * 5)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
-
-	if err != nil {
-		LogErrorResponse("AddRoute", err, reqParams)
+	if err := addRoutes(config, d.Timeout(schema.TimeoutCreate), *routeTable.VpcNo, d.Get("route_table_no").(string), []*vpc.RouteParameter{expandRouteParameter(d)}); err != nil {
 		return err
 	}
 
-	LogResponse("AddRoute", resp)
-
 	d.SetId(routeRuleHash(d.Get("route_table_no").(string), d.Get("destination_cidr_block").(string)))
 
 	log.Printf("[INFO] Route ID: %s", d.Id())
 
-	if err := WaitForNcloudRouteTableUpdate(config, d.Get("route_table_no").(string)); err != nil {
-		return err
-	}
-
 	return resourceNcloudRouteRead(d, meta)
 }
 
@@ -187,51 +154,16 @@ func resourceNcloudRouteUpdate(d *schema.ResourceData, meta interface{}) error {
 func resourceNcloudRouteDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*conn.ProviderConfig)
 
-	routeParams := &vpc.RouteParameter{
+	return removeRoutes(config, d.Timeout(schema.TimeoutDelete), d.Get("vpc_no").(string), d.Get("route_table_no").(string), []*vpc.RouteParameter{expandRouteParameter(d)})
+}
+
+func expandRouteParameter(d *schema.ResourceData) *vpc.RouteParameter {
+	return &vpc.RouteParameter{
 		DestinationCidrBlock: ncloud.String(d.Get("destination_cidr_block").(string)),
 		TargetTypeCode:       ncloud.String(d.Get("target_type").(string)),
 		TargetName:           ncloud.String(d.Get("target_name").(string)),
 		TargetNo:             ncloud.String(d.Get("target_no").(string)),
 	}
-
-	reqParams := &vpc.RemoveRouteRequest{
-		RegionCode:   &config.RegionCode,
-		VpcNo:        ncloud.String(d.Get("vpc_no").(string)),
-		RouteTableNo: ncloud.String(d.Get("route_table_no").(string)),
-		RouteList:    []*vpc.RouteParameter{routeParams},
-	}
-
-	var resp *vpc.RemoveRouteResponse
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		var err error
-
-		LogCommonRequest("RemoveRoute", reqParams)
-		resp, err = config.Client.Vpc.V2Api.RemoveRoute(reqParams)
-
-		if err != nil {
-			errBody, _ := GetCommonErrorBody(err)
-			if errBody.ReturnCode == "1017013" {
-				LogErrorResponse("retry remove Route", err, reqParams)
-				time.Sleep(time.Second * 5)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
-
-	if err != nil {
-		LogErrorResponse("RemoveRoute", err, reqParams)
-		return err
-	}
-
-	LogResponse("RemoveRoute", resp)
-
-	if err := WaitForNcloudRouteTableUpdate(config, d.Get("route_table_no").(string)); err != nil {
-		return err
-	}
-
-	return nil
 }
 
 func WaitForNcloudRouteTableUpdate(config *conn.ProviderConfig, id string) error {
@@ -1,6 +1,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -23,7 +24,20 @@ func ResourceNcloudRouteTable() *schema.Resource {
 		Update: resourceNcloudRouteTableUpdate,
 		Delete: resourceNcloudRouteTableDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("remove_undeclared_routes", false)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			// With remove_undeclared_routes, an omitted `route` means no routes, so routes added by ncloud_route show up as drift
+			if d.Get("remove_undeclared_routes").(bool) && d.GetRawConfig().GetAttr("route").IsNull() && d.Get("route").(*schema.Set).Len() > 0 {
+				if err := d.SetNew("route", []interface{}{}); err != nil {
+					return err
+				}
+			}
+
+			return checkRouteTableRouteTargets(ctx, d, meta.(*conn.ProviderConfig))
 		},
 		Schema: map[string]*schema.Schema{
 			"vpc_no": {
@@ -50,6 +64,20 @@ func ResourceNcloudRouteTable() *schema.Resource {
 				Computed:         true,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(0, 1000)),
 			},
+			"route": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				ConfigMode:  schema.SchemaConfigModeAttr,
+				Elem:        routeTableRouteSchema,
+				Description: "Authoritative list of the routes of the Route Table, except the default ones. When omitted, routes are left to `ncloud_route`.",
+			},
+			"remove_undeclared_routes": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Treat an omitted `route` as an empty list, so that routes not declared in `route` are always removed.",
+			},
 			"route_table_no": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -97,6 +125,12 @@ func resourceNcloudRouteTableCreate(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
+	if v, ok := d.GetOk("route"); ok {
+		if err := addRoutes(config, d.Timeout(schema.TimeoutCreate), d.Get("vpc_no").(string), d.Id(), expandRouteParameters(v.(*schema.Set).List())); err != nil {
+			return err
+		}
+	}
+
 	return resourceNcloudRouteTableRead(d, meta)
 }
 
@@ -121,6 +155,15 @@ func resourceNcloudRouteTableRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("supported_subnet_type", instance.SupportedSubnetType.Code)
 	d.Set("is_default", instance.IsDefault)
 
+	routes, err := GetRouteList(config, *instance.VpcNo, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("route", flattenRoutes(routes).List()); err != nil {
+		log.Printf("[WARN] Error setting route set for (%s): %s", d.Id(), err)
+	}
+
 	return nil
 }
 
@@ -133,12 +176,29 @@ func resourceNcloudRouteTableUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
+	if d.HasChange("route") {
+		o, n := d.GetChange("route")
+		if err := reconcileRoutes(config, d.Timeout(schema.TimeoutUpdate), d.Get("vpc_no").(string), d.Id(), o.(*schema.Set), n.(*schema.Set)); err != nil {
+			return err
+		}
+	}
+
 	return resourceNcloudRouteTableRead(d, meta)
 }
 
 func resourceNcloudRouteTableDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*conn.ProviderConfig)
 
+	// Remaining routes, such as inline ones, have to go before the Route Table can be deleted
+	routes, err := GetRouteList(config, d.Get("vpc_no").(string), d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := removeRoutes(config, d.Timeout(schema.TimeoutDelete), d.Get("vpc_no").(string), d.Id(), expandRouteParameters(flattenRoutes(routes).List())); err != nil {
+		return err
+	}
+
 	reqParams := &vpc.DeleteRouteTableRequest{
 		RegionCode:   &config.RegionCode,
 		RouteTableNo: ncloud.String(d.Get("route_table_no").(string)),
@@ -218,3 +218,32 @@ func convInstanceID(id string) (string, string, error) {
 	}
 	return idParts[0], idParts[1], nil
 }
+
+// GetSubnetRouteTableNo returns the number of the Route Table associated with the subnet, or "" if none is found.
+func GetSubnetRouteTableNo(config *conn.ProviderConfig, vpcNo, subnetNo string) (string, error) {
+	reqParams := &vpc.GetRouteTableListRequest{
+		RegionCode: &config.RegionCode,
+		VpcNo:      ncloud.String(vpcNo),
+	}
+
+	LogCommonRequest("GetRouteTableList", reqParams)
+	resp, err := config.Client.Vpc.V2Api.GetRouteTableList(reqParams)
+	if err != nil {
+		LogErrorResponse("GetRouteTableList", err, reqParams)
+		return "", err
+	}
+	LogResponse("GetRouteTableList", resp)
+
+	for _, r := range resp.RouteTableList {
+		subnet, err := GetRouteTableAssociationInstance(config, convAssociationID(ncloud.StringValue(r.RouteTableNo), subnetNo))
+		if err != nil {
+			return "", err
+		}
+
+		if subnet != nil {
+			return ncloud.StringValue(r.RouteTableNo), nil
+		}
+	}
+
+	return "", nil
+}
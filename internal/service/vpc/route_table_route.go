@@ -0,0 +1,234 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+var routeTableRouteSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"destination_cidr_block": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IsCIDRNetwork(0, 32)),
+		},
+		"target_type": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"NATGW", "VPCPEERING", "VGW"}, false)),
+		},
+		"target_no": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"target_name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	},
+}
+
+// routeTableLocks serializes route changes per Route Table within the provider process,
+// so that batches of ncloud_route_table and ncloud_route don't hit ApiErrorRouteTableChangingRoutes.
+var routeTableLocks KeyedMutex
+
+// checkNatGatewayRouteTarget rejects default routes to a PRIVATE NAT Gateway, which has no path to the internet.
+func checkNatGatewayRouteTarget(ctx context.Context, config *conn.ProviderConfig, targetType, targetNo, destinationCidrBlock string) error {
+	if targetType != "NATGW" || len(targetNo) == 0 || destinationCidrBlock != "0.0.0.0/0" {
+		return nil
+	}
+
+	instance, err := GetNatGatewayInstance(ctx, config, targetNo)
+	if err != nil {
+		return err
+	}
+
+	if instance == nil {
+		return nil
+	}
+
+	if natGatewayType := ncloud.StringValue(GetCodePtrByCommonCode(instance.NatGatewayType)); natGatewayType == "PRIVATE" {
+		return fmt.Errorf("NAT Gateway (%s) is a PRIVATE NAT Gateway and can't be the target of a route to %s. "+
+			"Use a PUBLIC NAT Gateway for internet access", targetNo, destinationCidrBlock)
+	}
+
+	return nil
+}
+
+// checkRouteTableRouteTargets runs checkNatGatewayRouteTarget on each route of a changed `route` set.
+func checkRouteTableRouteTargets(ctx context.Context, d *schema.ResourceDiff, config *conn.ProviderConfig) error {
+	if !d.HasChange("route") || !d.NewValueKnown("route") {
+		return nil
+	}
+
+	for _, vi := range d.Get("route").(*schema.Set).List() {
+		m := vi.(map[string]interface{})
+		if err := checkNatGatewayRouteTarget(ctx, config, m["target_type"].(string), m["target_no"].(string), m["destination_cidr_block"].(string)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func GetRouteList(config *conn.ProviderConfig, vpcNo, routeTableNo string) ([]*vpc.Route, error) {
+	reqParams := &vpc.GetRouteListRequest{
+		RegionCode:   &config.RegionCode,
+		VpcNo:        ncloud.String(vpcNo),
+		RouteTableNo: ncloud.String(routeTableNo),
+	}
+
+	LogCommonRequest("GetRouteList", reqParams)
+	resp, err := config.Client.Vpc.V2Api.GetRouteList(reqParams)
+	if err != nil {
+		LogErrorResponse("GetRouteList", err, reqParams)
+		return nil, err
+	}
+	LogResponse("GetRouteList", resp)
+
+	return resp.RouteList, nil
+}
+
+// addRoutes adds routes to a Route Table in a single AddRoute call and waits for the Route Table to settle.
+func addRoutes(config *conn.ProviderConfig, timeout time.Duration, vpcNo, routeTableNo string, routes []*vpc.RouteParameter) error {
+	if len(routes) == 0 {
+		return nil
+	}
+
+	unlock := routeTableLocks.Lock(routeTableNo)
+	defer unlock()
+
+	reqParams := &vpc.AddRouteRequest{
+		RegionCode:   &config.RegionCode,
+		VpcNo:        ncloud.String(vpcNo),
+		RouteTableNo: ncloud.String(routeTableNo),
+		RouteList:    routes,
+	}
+
+	var resp *vpc.AddRouteResponse
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		var err error
+
+		LogCommonRequest("AddRoute", reqParams)
+		resp, err = config.Client.Vpc.V2Api.AddRoute(reqParams)
+
+		if err != nil {
+			errBody, _ := GetCommonErrorBody(err)
+			if errBody.ReturnCode == ApiErrorRouteTableChangingRoutes {
+				LogErrorResponse("retry add Route", err, reqParams)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		LogErrorResponse("AddRoute", err, reqParams)
+		return err
+	}
+
+	LogResponse("AddRoute", resp)
+
+	return WaitForNcloudRouteTableUpdate(config, routeTableNo)
+}
+
+// removeRoutes removes routes from a Route Table in a single RemoveRoute call and waits for the Route Table to settle.
+func removeRoutes(config *conn.ProviderConfig, timeout time.Duration, vpcNo, routeTableNo string, routes []*vpc.RouteParameter) error {
+	if len(routes) == 0 {
+		return nil
+	}
+
+	unlock := routeTableLocks.Lock(routeTableNo)
+	defer unlock()
+
+	reqParams := &vpc.RemoveRouteRequest{
+		RegionCode:   &config.RegionCode,
+		VpcNo:        ncloud.String(vpcNo),
+		RouteTableNo: ncloud.String(routeTableNo),
+		RouteList:    routes,
+	}
+
+	var resp *vpc.RemoveRouteResponse
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		var err error
+
+		LogCommonRequest("RemoveRoute", reqParams)
+		resp, err = config.Client.Vpc.V2Api.RemoveRoute(reqParams)
+
+		if err != nil {
+			errBody, _ := GetCommonErrorBody(err)
+			if errBody.ReturnCode == ApiErrorRouteTableChangingRoutes {
+				LogErrorResponse("retry remove Route", err, reqParams)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		LogErrorResponse("RemoveRoute", err, reqParams)
+		return err
+	}
+
+	LogResponse("RemoveRoute", resp)
+
+	return WaitForNcloudRouteTableUpdate(config, routeTableNo)
+}
+
+// reconcileRoutes removes the routes of current missing from desired, then adds the routes of desired missing from current.
+func reconcileRoutes(config *conn.ProviderConfig, timeout time.Duration, vpcNo, routeTableNo string, current, desired *schema.Set) error {
+	if err := removeRoutes(config, timeout, vpcNo, routeTableNo, expandRouteParameters(current.Difference(desired).List())); err != nil {
+		return err
+	}
+
+	return addRoutes(config, timeout, vpcNo, routeTableNo, expandRouteParameters(desired.Difference(current).List()))
+}
+
+// flattenRoutes returns the routes as a set hashed like the `route` block.
+// Default routes, such as the local route of the VPC, are left out since they can't be changed.
+func flattenRoutes(routes []*vpc.Route) *schema.Set {
+	set := schema.NewSet(schema.HashResource(routeTableRouteSchema), []interface{}{})
+
+	for _, r := range routes {
+		if ncloud.BoolValue(r.IsDefault) {
+			continue
+		}
+
+		set.Add(map[string]interface{}{
+			"destination_cidr_block": ncloud.StringValue(r.DestinationCidrBlock),
+			"target_type":            ncloud.StringValue(GetCodePtrByCommonCode(r.TargetType)),
+			"target_no":              ncloud.StringValue(r.TargetNo),
+			"target_name":            ncloud.StringValue(r.TargetName),
+		})
+	}
+
+	return set
+}
+
+func expandRouteParameters(routes []interface{}) []*vpc.RouteParameter {
+	var routeList []*vpc.RouteParameter
+
+	for _, vi := range routes {
+		m := vi.(map[string]interface{})
+		routeList = append(routeList, &vpc.RouteParameter{
+			DestinationCidrBlock: ncloud.String(m["destination_cidr_block"].(string)),
+			TargetTypeCode:       ncloud.String(m["target_type"].(string)),
+			TargetNo:             ncloud.String(m["target_no"].(string)),
+			TargetName:           ncloud.String(m["target_name"].(string)),
+		})
+	}
+
+	return routeList
+}
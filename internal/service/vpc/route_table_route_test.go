@@ -0,0 +1,37 @@
+package vpc
+
+import (
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+)
+
+func TestFlattenRoutes(t *testing.T) {
+	routes := []*vpc.Route{
+		{
+			DestinationCidrBlock: ncloud.String("10.0.0.0/16"),
+			TargetType:           &vpc.CommonCode{Code: ncloud.String("LOCAL")},
+			TargetName:           ncloud.String("LOCAL"),
+			IsDefault:            ncloud.Bool(true),
+		},
+		{
+			DestinationCidrBlock: ncloud.String("0.0.0.0/0"),
+			TargetType:           &vpc.CommonCode{Code: ncloud.String("NATGW")},
+			TargetNo:             ncloud.String("1234"),
+			TargetName:           ncloud.String("nat"),
+			IsDefault:            ncloud.Bool(false),
+		},
+	}
+
+	set := flattenRoutes(routes)
+	if set.Len() != 1 {
+		t.Fatalf("expected the default route to be left out, got %d routes", set.Len())
+	}
+
+	params := expandRouteParameters(set.List())
+	if ncloud.StringValue(params[0].DestinationCidrBlock) != "0.0.0.0/0" || ncloud.StringValue(params[0].TargetTypeCode) != "NATGW" ||
+		ncloud.StringValue(params[0].TargetNo) != "1234" || ncloud.StringValue(params[0].TargetName) != "nat" {
+		t.Fatalf("unexpected route parameter: %+v", params[0])
+	}
+}
@@ -249,3 +249,109 @@ func testAccCheckRouteTableDisappears(instance *vpc.RouteTable) resource.TestChe
 		return err
 	}
 }
+
+func TestAccResourceNcloudRouteTable_inlineRoutes(t *testing.T) {
+	var routeTable vpc.RouteTable
+	resourceName := "ncloud_route_table.test"
+	name := fmt.Sprintf("test-table-route-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRouteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudRouteTableConfigInlineRoutes(name, `["10.10.0.0/16", "10.20.0.0/16"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableExists(resourceName, &routeTable),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"destination_cidr_block": "10.10.0.0/16",
+						"target_type":            "NATGW",
+					}),
+				),
+			},
+			{
+				Config: testAccResourceNcloudRouteTableConfigInlineRoutes(name, `["10.20.0.0/16", "10.30.0.0/16", "10.40.0.0/16"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "route.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"destination_cidr_block": "10.40.0.0/16",
+					}),
+				),
+			},
+			{
+				Config: testAccResourceNcloudRouteTableConfigInlineRoutes(name, `[]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "route.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudRouteTable_removeUndeclaredRoutes(t *testing.T) {
+	name := fmt.Sprintf("test-table-excl-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRouteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudRouteTableConfigInlineRoutes(name, `["10.10.0.0/16"]`),
+			},
+			{
+				Config: testAccResourceNcloudRouteTableConfigInlineRoutes(name, `["10.10.0.0/16"]`) + `
+resource "ncloud_route" "test" {
+	route_table_no         = ncloud_route_table.test.id
+	destination_cidr_block = "10.50.0.0/16"
+	target_type            = "NATGW"
+	target_name            = ncloud_nat_gateway.test.name
+	target_no              = ncloud_nat_gateway.test.id
+}
+`,
+				// The Route Table reports the route of ncloud_route as drift and removes it
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccResourceNcloudRouteTableConfigInlineRoutes(name, destinations string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "test" {
+	name            = "%[1]s"
+	ipv4_cidr_block = "10.3.0.0/16"
+}
+
+resource "ncloud_subnet" "test" {
+	vpc_no         = ncloud_vpc.test.id
+	subnet         = cidrsubnet(ncloud_vpc.test.ipv4_cidr_block, 8, 1)
+	zone           = "KR-1"
+	network_acl_no = ncloud_vpc.test.default_network_acl_no
+	subnet_type    = "PUBLIC"
+	usage_type     = "NATGW"
+}
+
+resource "ncloud_nat_gateway" "test" {
+	vpc_no    = ncloud_vpc.test.id
+	subnet_no = ncloud_subnet.test.id
+	zone      = "KR-1"
+}
+
+resource "ncloud_route_table" "test" {
+	vpc_no                   = ncloud_vpc.test.id
+	name                     = "%[1]s"
+	supported_subnet_type    = "PRIVATE"
+	remove_undeclared_routes = true
+
+	route = [for cidr in %[2]s : {
+		destination_cidr_block = cidr
+		target_type            = "NATGW"
+		target_name            = ncloud_nat_gateway.test.name
+		target_no              = ncloud_nat_gateway.test.id
+	}]
+}
+`, name, destinations)
+}
@@ -3,8 +3,10 @@ package vpc
 import (
 	"context"
 	"fmt"
+	"net"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -15,6 +17,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -34,6 +37,7 @@ var (
 	_ resource.Resource                = &subnetResource{}
 	_ resource.ResourceWithConfigure   = &subnetResource{}
 	_ resource.ResourceWithImportState = &subnetResource{}
+	_ resource.ResourceWithModifyPlan  = &subnetResource{}
 )
 
 func NewSubnetResource() resource.Resource {
@@ -73,12 +77,27 @@ func (s *subnetResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Description: "The id of the VPC that the desired subnet belongs to.",
 			},
 			"subnet": schema.StringAttribute{
-				Required: true,
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: verify.CidrBlockValidator(),
 			},
+			"netmask_length": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(16, 28),
+					int64validator.ExactlyOneOf(path.MatchRoot("subnet")),
+				},
+				Description: "Netmask length of a subnet block allocated from the first free range of the VPC `ipv4_cidr_block`.",
+			},
 			"zone": schema.StringAttribute{
 				Required: true,
 				PlanModifiers: []planmodifier.String{
@@ -132,6 +151,80 @@ func (s *subnetResource) Configure(_ context.Context, req resource.ConfigureRequ
 	s.config = config
 }
 
+// ModifyPlan reports a planned subnet block that overlaps an existing subnet of the same VPC.
+// The subnet being replaced is left out, as it is destroyed first.
+func (s *subnetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan subnetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state subnetResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+		var configSubnet types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("subnet"), &configSubnet)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// `subnet` keeps the block from state, which has to be allocated again for another netmask length
+		if subnetNeedsReallocation(configSubnet, state.NetmaskLength, plan.NetmaskLength) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("subnet"), types.StringUnknown())...)
+			return
+		}
+	}
+
+	if plan.VpcNo.IsUnknown() || plan.Subnet.IsUnknown() || plan.Subnet.IsNull() {
+		return
+	}
+
+	if plan.NetmaskLength.IsUnknown() {
+		if _, ipnet, err := net.ParseCIDR(plan.Subnet.ValueString()); err == nil {
+			ones, _ := ipnet.Mask.Size()
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("netmask_length"), int64(ones))...)
+		}
+	}
+
+	var id string
+	if !req.State.Raw.IsNull() {
+		var state subnetResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if state.Subnet.Equal(plan.Subnet) && state.VpcNo.Equal(plan.VpcNo) {
+			return
+		}
+		id = state.ID.ValueString()
+	}
+
+	if s.config == nil {
+		return
+	}
+
+	used, err := getVpcSubnetCidrList(s.config, plan.VpcNo.ValueString(), id)
+	if err != nil {
+		resp.Diagnostics.AddError("GetSubnetList", err.Error())
+		return
+	}
+
+	if overlap := overlappingSubnetCidr(plan.Subnet.ValueString(), used); len(overlap) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("subnet"),
+			"Overlapping subnet",
+			fmt.Sprintf("subnet %s overlaps subnet %s of the VPC (%s)", plan.Subnet.ValueString(), overlap, plan.VpcNo.ValueString()),
+		)
+	}
+}
+
 func (s *subnetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan subnetResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -140,6 +233,40 @@ func (s *subnetResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	// The lock is held until the subnet is created, so that subnets created in parallel see each other in GetSubnetList
+	unlock := subnetAllocationLocks.Lock(plan.VpcNo.ValueString())
+	if plan.Subnet.IsUnknown() || plan.Subnet.IsNull() {
+		cidr, err := s.allocateSubnet(plan.VpcNo.ValueString(), int(plan.NetmaskLength.ValueInt64()))
+		if err != nil {
+			unlock()
+			resp.Diagnostics.AddError("fail to allocate subnet CIDR block", err.Error())
+			return
+		}
+
+		tflog.Info(ctx, "Allocated subnet CIDR block", map[string]any{
+			"vpcNo":  plan.VpcNo.ValueString(),
+			"subnet": cidr,
+		})
+		plan.Subnet = types.StringValue(cidr)
+	} else {
+		used, err := getVpcSubnetCidrList(s.config, plan.VpcNo.ValueString(), "")
+		if err != nil {
+			unlock()
+			resp.Diagnostics.AddError("fail to get subnet list", err.Error())
+			return
+		}
+
+		if overlap := overlappingSubnetCidr(plan.Subnet.ValueString(), used); len(overlap) > 0 {
+			unlock()
+			resp.Diagnostics.AddAttributeError(
+				path.Root("subnet"),
+				"fail to create subnet",
+				fmt.Sprintf("subnet %s overlaps subnet %s of the VPC (%s)", plan.Subnet.ValueString(), overlap, plan.VpcNo.ValueString()),
+			)
+			return
+		}
+	}
+
 	reqParams := &vpc.CreateSubnetRequest{
 		RegionCode:     &s.config.RegionCode,
 		Subnet:         plan.Subnet.ValueStringPointer(),
@@ -179,6 +306,7 @@ func (s *subnetResource) Create(ctx context.Context, req resource.CreateRequest,
 		}
 		return nil
 	})
+	unlock()
 
 	if err != nil {
 		resp.Diagnostics.AddError("fail to create subnet", err.Error())
@@ -321,6 +449,35 @@ func (s *subnetResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+// subnetNeedsReallocation reports whether an allocated subnet block has to be picked again, because
+// `subnet` is not configured and `netmask_length` changed.
+func subnetNeedsReallocation(configSubnet types.String, stateNetmaskLength, planNetmaskLength types.Int64) bool {
+	if !configSubnet.IsNull() || planNetmaskLength.IsUnknown() || planNetmaskLength.IsNull() {
+		return false
+	}
+
+	return !stateNetmaskLength.Equal(planNetmaskLength)
+}
+
+// allocateSubnet picks the first free block of the VPC, skipping its existing subnets.
+func (s *subnetResource) allocateSubnet(vpcNo string, netmaskLength int) (string, error) {
+	vpcInstance, err := GetVpcInstance(s.config, vpcNo)
+	if err != nil {
+		return "", err
+	}
+
+	if vpcInstance == nil {
+		return "", fmt.Errorf("no matching VPC: %s", vpcNo)
+	}
+
+	used, err := getVpcSubnetCidrList(s.config, vpcNo, "")
+	if err != nil {
+		return "", err
+	}
+
+	return allocateSubnetCidr(ncloud.StringValue(vpcInstance.Ipv4CidrBlock), netmaskLength, used)
+}
+
 func waitForNcloudSubnetCreation(config *conn.ProviderConfig, id string) (*vpc.Subnet, error) {
 	var subnetInstance *vpc.Subnet
 	stateConf := &sdkresource.StateChangeConf{
@@ -406,15 +563,16 @@ func GetSubnetInstance(config *conn.ProviderConfig, id string) (*vpc.Subnet, err
 }
 
 type subnetResourceModel struct {
-	NetworkAclNo types.String `tfsdk:"network_acl_no"`
-	VpcNo        types.String `tfsdk:"vpc_no"`
-	ID           types.String `tfsdk:"id"`
-	Subnet       types.String `tfsdk:"subnet"`
-	Zone         types.String `tfsdk:"zone"`
-	SubnetType   types.String `tfsdk:"subnet_type"`
-	UsageType    types.String `tfsdk:"usage_type"`
-	Name         types.String `tfsdk:"name"`
-	SubnetNo     types.String `tfsdk:"subnet_no"`
+	NetworkAclNo  types.String `tfsdk:"network_acl_no"`
+	VpcNo         types.String `tfsdk:"vpc_no"`
+	ID            types.String `tfsdk:"id"`
+	Subnet        types.String `tfsdk:"subnet"`
+	NetmaskLength types.Int64  `tfsdk:"netmask_length"`
+	Zone          types.String `tfsdk:"zone"`
+	SubnetType    types.String `tfsdk:"subnet_type"`
+	UsageType     types.String `tfsdk:"usage_type"`
+	Name          types.String `tfsdk:"name"`
+	SubnetNo      types.String `tfsdk:"subnet_no"`
 }
 
 func (m *subnetResourceModel) refreshFromOutput(output *vpc.Subnet) error {
@@ -424,6 +582,13 @@ func (m *subnetResourceModel) refreshFromOutput(output *vpc.Subnet) error {
 	m.Zone = types.StringPointerValue(output.ZoneCode)
 	m.Name = types.StringPointerValue(output.SubnetName)
 	m.Subnet = types.StringPointerValue(output.Subnet)
+
+	_, ipnet, err := net.ParseCIDR(ncloud.StringValue(output.Subnet))
+	if err != nil {
+		return err
+	}
+	ones, _ := ipnet.Mask.Size()
+	m.NetmaskLength = types.Int64Value(int64(ones))
 	m.SubnetType = types.StringPointerValue(output.SubnetType.Code)
 	m.UsageType = types.StringPointerValue(output.UsageType.Code)
 	m.NetworkAclNo = types.StringPointerValue(output.NetworkAclNo)
@@ -0,0 +1,92 @@
+package vpc
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify"
+)
+
+// subnetAllocationLocks serializes CIDR allocation per VPC within the provider process,
+// so subnets created in parallel do not pick the same free block.
+var subnetAllocationLocks common.KeyedMutex
+
+// getVpcSubnetCidrList returns the CIDR blocks of the subnets of vpcNo, except the subnet excludeSubnetNo.
+func getVpcSubnetCidrList(config *conn.ProviderConfig, vpcNo, excludeSubnetNo string) ([]string, error) {
+	reqParams := &vpc.GetSubnetListRequest{
+		RegionCode: &config.RegionCode,
+		VpcNo:      ncloud.String(vpcNo),
+	}
+
+	common.LogCommonRequest("GetSubnetList", reqParams)
+	resp, err := config.Client.Vpc.V2Api.GetSubnetList(reqParams)
+	if err != nil {
+		common.LogErrorResponse("GetSubnetList", err, reqParams)
+		return nil, err
+	}
+	common.LogResponse("GetSubnetList", resp)
+
+	var cidrs []string
+	for _, s := range resp.SubnetList {
+		if status := ncloud.StringValue(common.GetCodePtrByCommonCode(s.SubnetStatus)); status == "TERMTING" || status == "TERMINATED" {
+			continue
+		}
+		if len(excludeSubnetNo) > 0 && ncloud.StringValue(s.SubnetNo) == excludeSubnetNo {
+			continue
+		}
+		cidrs = append(cidrs, ncloud.StringValue(s.Subnet))
+	}
+
+	return cidrs, nil
+}
+
+// overlappingSubnetCidr returns the first block of used that overlaps cidr, or an empty string.
+func overlappingSubnetCidr(cidr string, used []string) string {
+	for _, u := range used {
+		if verify.CIDRBlocksOverlap(cidr, u) {
+			return u
+		}
+	}
+
+	return ""
+}
+
+// allocateSubnetCidr returns the first block with the given netmask length within vpcCidr that overlaps none of used.
+func allocateSubnetCidr(vpcCidr string, netmaskLength int, used []string) (string, error) {
+	_, vpcNet, err := net.ParseCIDR(vpcCidr)
+	if err != nil {
+		return "", err
+	}
+
+	vpcIp := vpcNet.IP.To4()
+	if vpcIp == nil {
+		return "", fmt.Errorf("%q is not an IPv4 CIDR block", vpcCidr)
+	}
+
+	vpcLength, _ := vpcNet.Mask.Size()
+	if netmaskLength < vpcLength || netmaskLength > 32 {
+		return "", fmt.Errorf("netmask length %d does not fit in VPC CIDR block %s", netmaskLength, vpcCidr)
+	}
+
+	start := binary.BigEndian.Uint32(vpcIp)
+	size := uint64(1) << (32 - netmaskLength)
+	end := uint64(start) + (uint64(1) << (32 - vpcLength))
+
+	for base := uint64(start); base < end; base += size {
+		ip := make(net.IP, 4)
+		binary.BigEndian.PutUint32(ip, uint32(base))
+		candidate := fmt.Sprintf("%s/%d", ip, netmaskLength)
+
+		if len(overlappingSubnetCidr(candidate, used)) == 0 {
+			return candidate, nil
+		}
+	}
+
+	return "", fmt.Errorf("no free /%d block left in VPC CIDR block %s", netmaskLength, vpcCidr)
+}
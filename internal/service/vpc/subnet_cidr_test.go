@@ -0,0 +1,85 @@
+package vpc
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAllocateSubnetCidr(t *testing.T) {
+	cases := []struct {
+		Name          string
+		VpcCidr       string
+		NetmaskLength int
+		Used          []string
+		Expected      string
+		Error         bool
+	}{
+		{Name: "empty vpc", VpcCidr: "10.0.0.0/16", NetmaskLength: 24, Expected: "10.0.0.0/24"},
+		{Name: "skips used", VpcCidr: "10.0.0.0/16", NetmaskLength: 24, Used: []string{"10.0.0.0/24", "10.0.1.0/24"}, Expected: "10.0.2.0/24"},
+		{Name: "fills gap", VpcCidr: "10.0.0.0/16", NetmaskLength: 24, Used: []string{"10.0.0.0/24", "10.0.2.0/24"}, Expected: "10.0.1.0/24"},
+		{Name: "skips larger block", VpcCidr: "10.0.0.0/16", NetmaskLength: 24, Used: []string{"10.0.0.0/22"}, Expected: "10.0.4.0/24"},
+		{Name: "skips smaller block", VpcCidr: "10.0.0.0/16", NetmaskLength: 22, Used: []string{"10.0.1.128/26"}, Expected: "10.0.4.0/22"},
+		{Name: "full", VpcCidr: "10.0.0.0/23", NetmaskLength: 24, Used: []string{"10.0.0.0/24", "10.0.1.0/24"}, Error: true},
+		{Name: "larger than vpc", VpcCidr: "10.0.0.0/24", NetmaskLength: 16, Error: true},
+		{Name: "invalid vpc", VpcCidr: "invalid", NetmaskLength: 24, Error: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			cidr, err := allocateSubnetCidr(tc.VpcCidr, tc.NetmaskLength, tc.Used)
+			if tc.Error {
+				if err == nil {
+					t.Fatalf("expected an error, got %s", cidr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if cidr != tc.Expected {
+				t.Fatalf("expected %s, got %s", tc.Expected, cidr)
+			}
+		})
+	}
+}
+
+func TestOverlappingSubnetCidr(t *testing.T) {
+	used := []string{"10.0.0.0/24", "10.0.1.0/24"}
+
+	if overlap := overlappingSubnetCidr("10.0.2.0/24", used); overlap != "" {
+		t.Fatalf("expected no overlap, got %s", overlap)
+	}
+
+	if overlap := overlappingSubnetCidr("10.0.1.128/25", used); overlap != "10.0.1.0/24" {
+		t.Fatalf("expected an overlap with 10.0.1.0/24, got %q", overlap)
+	}
+
+	if overlap := overlappingSubnetCidr("10.0.0.0/16", used); overlap != "10.0.0.0/24" {
+		t.Fatalf("expected an overlap with 10.0.0.0/24, got %q", overlap)
+	}
+}
+
+func TestSubnetNeedsReallocation(t *testing.T) {
+	cases := []struct {
+		Name         string
+		ConfigSubnet types.String
+		State, Plan  types.Int64
+		Expected     bool
+	}{
+		{Name: "netmask length changed", ConfigSubnet: types.StringNull(), State: types.Int64Value(24), Plan: types.Int64Value(26), Expected: true},
+		{Name: "netmask length unchanged", ConfigSubnet: types.StringNull(), State: types.Int64Value(24), Plan: types.Int64Value(24)},
+		{Name: "subnet configured", ConfigSubnet: types.StringValue("10.0.0.0/26"), State: types.Int64Value(24), Plan: types.Int64Value(26)},
+		{Name: "netmask length unknown", ConfigSubnet: types.StringNull(), State: types.Int64Value(24), Plan: types.Int64Unknown()},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if got := subnetNeedsReallocation(tc.ConfigSubnet, tc.State, tc.Plan); got != tc.Expected {
+				t.Fatalf("expected %t, got %t", tc.Expected, got)
+			}
+		})
+	}
+}
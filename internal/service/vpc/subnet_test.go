@@ -146,6 +146,54 @@ func TestAccResourceNcloudSubnet_InvalidCIDR(t *testing.T) {
 	})
 }
 
+func TestAccResourceNcloudSubnet_netmaskLength(t *testing.T) {
+	var subnet vpc.Subnet
+	name := fmt.Sprintf("test-subnet-netmask-%s", sdkacctest.RandString(5))
+	resourceName := "ncloud_subnet.baz"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSubnetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudSubnetConfigNetmaskLength(name, 24),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubnetExists(resourceName, &subnet),
+					resource.TestCheckResourceAttr(resourceName, "subnet", "10.2.1.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "netmask_length", "24"),
+					resource.TestCheckResourceAttr("ncloud_subnet.bar", "netmask_length", "24"),
+				),
+			},
+			{
+				// A new netmask length replaces the subnet with a newly allocated block
+				Config: testAccResourceNcloudSubnetConfigNetmaskLength(name, 26),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubnetExists(resourceName, &subnet),
+					resource.TestCheckResourceAttr(resourceName, "subnet", "10.2.1.0/26"),
+					resource.TestCheckResourceAttr(resourceName, "netmask_length", "26"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudSubnet_overlap(t *testing.T) {
+	name := fmt.Sprintf("test-subnet-overlap-%s", sdkacctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSubnetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceNcloudSubnetConfigOverlap(name),
+				ExpectError: regexp.MustCompile("overlaps subnet"),
+			},
+		},
+	})
+}
+
 func testAccResourceNcloudSubnetConfig(name, cidr string) string {
 	return fmt.Sprintf(`
 resource "ncloud_vpc" "foo" {
@@ -209,6 +257,34 @@ resource "ncloud_subnet" "bar" {
 `, name, cidr)
 }
 
+func testAccResourceNcloudSubnetConfigNetmaskLength(name string, netmaskLength int) string {
+	return testAccResourceNcloudSubnetConfig(name, "10.2.0.0/24") + fmt.Sprintf(`
+resource "ncloud_subnet" "baz" {
+	vpc_no             = ncloud_vpc.foo.vpc_no
+	name               = "%[1]s-auto"
+	netmask_length     = %[2]d
+	zone               = "KR-1"
+	network_acl_no     = ncloud_vpc.foo.default_network_acl_no
+	subnet_type        = "PRIVATE"
+
+	depends_on = [ncloud_subnet.bar]
+}
+`, name, netmaskLength)
+}
+
+func testAccResourceNcloudSubnetConfigOverlap(name string) string {
+	return testAccResourceNcloudSubnetConfig(name, "10.2.0.0/24") + fmt.Sprintf(`
+resource "ncloud_subnet" "baz" {
+	vpc_no             = ncloud_vpc.foo.vpc_no
+	name               = "%[1]s-overlap"
+	subnet             = "10.2.0.128/25"
+	zone               = "KR-1"
+	network_acl_no     = ncloud_vpc.foo.default_network_acl_no
+	subnet_type        = "PRIVATE"
+}
+`, name)
+}
+
 func testAccCheckSubnetExists(n string, subnet *vpc.Subnet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
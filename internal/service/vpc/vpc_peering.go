@@ -189,12 +189,23 @@ func (v *vpcPeeringResource) Create(ctx context.Context, req resource.CreateRequ
 	plan.ID = types.StringPointerValue(instance.VpcPeeringInstanceNo)
 	tflog.Info(ctx, "VPC Peering ID: %s", map[string]any{"vpcPeeringNo": *instance.VpcPeeringInstanceNo})
 
-	output, err := waitForNcloudVpcPeeringCreation(ctx, v.config, *instance.VpcPeeringInstanceNo)
+	var output *vpc.VpcPeeringInstance
+	if ncloud.BoolValue(instance.IsBetweenAccounts) {
+		// Cross-account peerings stay pending until the target account accepts them, e.g. with ncloud_vpc_peering_accepter
+		output, err = GetVpcPeeringInstance(ctx, v.config, *instance.VpcPeeringInstanceNo)
+	} else {
+		output, err = waitForNcloudVpcPeeringCreation(ctx, v.config, *instance.VpcPeeringInstanceNo)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("waiting for Vpc peering creation", err.Error())
 		return
 	}
 
+	if output == nil {
+		resp.Diagnostics.AddError("waiting for Vpc peering creation", fmt.Sprintf("no matching VPC Peering: %s", *instance.VpcPeeringInstanceNo))
+		return
+	}
+
 	plan.refreshFromOutput(output)
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
@@ -0,0 +1,260 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkresource "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+)
+
+var (
+	_ resource.Resource                = &vpcPeeringAccepterResource{}
+	_ resource.ResourceWithConfigure   = &vpcPeeringAccepterResource{}
+	_ resource.ResourceWithImportState = &vpcPeeringAccepterResource{}
+)
+
+func NewVpcPeeringAccepterResource() resource.Resource {
+	return &vpcPeeringAccepterResource{}
+}
+
+type vpcPeeringAccepterResource struct {
+	config *conn.ProviderConfig
+}
+
+func (v *vpcPeeringAccepterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vpc_peering_no"), req.ID)...)
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (v *vpcPeeringAccepterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc_peering_accepter"
+}
+
+func (v *vpcPeeringAccepterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"vpc_peering_no": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "VPC peering request to accept. The provider must be configured with the account owning the target VPC.",
+			},
+			"name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_vpc_no": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_vpc_login_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"target_vpc_no": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_between_accounts": schema.BoolAttribute{
+				Computed: true,
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+			"id": framework.IDAttribute(),
+		},
+	}
+}
+
+func (v *vpcPeeringAccepterResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	v.config = config
+}
+
+func (v *vpcPeeringAccepterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vpcPeeringAccepterResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vpcPeeringNo := plan.VpcPeeringNo.ValueString()
+
+	output, err := GetVpcPeeringInstance(ctx, v.config, vpcPeeringNo)
+	if err != nil {
+		resp.Diagnostics.AddError("GetVpcPeering", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.Diagnostics.AddError("Error Accepting VPC Peering", fmt.Sprintf("no matching VPC Peering: %s", vpcPeeringNo))
+		return
+	}
+
+	// Same account peerings, or requests accepted in the console, are already running and only need to be tracked
+	if ncloud.StringValue(common.GetCodePtrByCommonCode(output.VpcPeeringInstanceStatus)) != "RUN" {
+		reqParams := &vpc.AcceptOrRejectVpcPeeringRequest{
+			RegionCode:           &v.config.RegionCode,
+			VpcPeeringInstanceNo: ncloud.String(vpcPeeringNo),
+			IsAccept:             ncloud.Bool(true),
+		}
+
+		tflog.Info(ctx, "AcceptOrRejectVpcPeering", map[string]any{
+			"reqParams": common.MarshalUncheckedString(reqParams),
+		})
+
+		response, err := v.config.Client.Vpc.V2Api.AcceptOrRejectVpcPeering(reqParams)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("accept vpc peering instance, err params=%v", *reqParams),
+				err.Error(),
+			)
+			return
+		}
+
+		tflog.Info(ctx, "AcceptOrRejectVpcPeering response", map[string]any{
+			"acceptOrRejectVpcPeeringResponse": common.MarshalUncheckedString(response),
+		})
+
+		output, err = waitForNcloudVpcPeeringAcceptance(ctx, v.config, vpcPeeringNo)
+		if err != nil {
+			resp.Diagnostics.AddError("waiting for Vpc peering acceptance", err.Error())
+			return
+		}
+	}
+
+	plan.refreshFromOutput(output)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (v *vpcPeeringAccepterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vpcPeeringAccepterResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := GetVpcPeeringInstance(ctx, v.config, state.VpcPeeringNo.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("GetVpcPeering", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if status := ncloud.StringValue(common.GetCodePtrByCommonCode(output.VpcPeeringInstanceStatus)); status == "TERMTING" || status == "TERMINATED" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.refreshFromOutput(output)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (v *vpcPeeringAccepterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+}
+
+// Delete only forgets the acceptance. The peering itself belongs to the requester, which deletes it with ncloud_vpc_peering.
+func (v *vpcPeeringAccepterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vpcPeeringAccepterResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Removing VPC Peering accepter from state, the VPC Peering is left as is", map[string]any{
+		"vpcPeeringNo": state.VpcPeeringNo.ValueString(),
+	})
+}
+
+func (m *vpcPeeringAccepterResourceModel) refreshFromOutput(output *vpc.VpcPeeringInstance) {
+	m.ID = types.StringPointerValue(output.VpcPeeringInstanceNo)
+	m.VpcPeeringNo = types.StringPointerValue(output.VpcPeeringInstanceNo)
+	m.Name = types.StringPointerValue(output.VpcPeeringName)
+	m.SourceVpcNo = types.StringPointerValue(output.SourceVpcNo)
+	m.SourceVpcLoginId = types.StringPointerValue(output.SourceVpcLoginId)
+	m.TargetVpcNo = types.StringPointerValue(output.TargetVpcNo)
+	m.IsBetweenAccounts = types.BoolPointerValue(output.IsBetweenAccounts)
+	m.Status = types.StringPointerValue(common.GetCodePtrByCommonCode(output.VpcPeeringInstanceStatus))
+}
+
+// waitForNcloudVpcPeeringAcceptance waits for an accepted peering to run.
+// A pending request may be reported with several statuses depending on the region, so anything but RUN or termination counts as pending.
+func waitForNcloudVpcPeeringAcceptance(ctx context.Context, config *conn.ProviderConfig, id string) (*vpc.VpcPeeringInstance, error) {
+	var vpcPeeringInstance *vpc.VpcPeeringInstance
+	stateConf := &sdkresource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"RUN"},
+		Refresh: func() (interface{}, string, error) {
+			instance, err := GetVpcPeeringInstance(ctx, config, id)
+			vpcPeeringInstance = instance
+
+			_, status, err := VpcCommonStateRefreshFunc(instance, err, "VpcPeeringInstanceStatus")
+			switch status {
+			case "RUN", "TERMINATED", "TERMTING":
+				return instance, status, err
+			default:
+				return instance, "PENDING", err
+			}
+		},
+		Timeout:    conn.DefaultCreateTimeout,
+		Delay:      2 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return nil, fmt.Errorf("Error waiting for VPC Peering (%s) to be accepted: %s", id, err)
+	}
+
+	return vpcPeeringInstance, nil
+}
+
+type vpcPeeringAccepterResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	VpcPeeringNo      types.String `tfsdk:"vpc_peering_no"`
+	Name              types.String `tfsdk:"name"`
+	SourceVpcNo       types.String `tfsdk:"source_vpc_no"`
+	SourceVpcLoginId  types.String `tfsdk:"source_vpc_login_id"`
+	TargetVpcNo       types.String `tfsdk:"target_vpc_no"`
+	IsBetweenAccounts types.Bool   `tfsdk:"is_between_accounts"`
+	Status            types.String `tfsdk:"status"`
+}
@@ -0,0 +1,50 @@
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+)
+
+// Accepting a request from another account needs a second account, so this covers tracking a same account peering,
+// which is running as soon as it is created.
+func TestAccResourceNcloudVpcPeeringAccepter_sameAccount(t *testing.T) {
+	resourceName := "ncloud_vpc_peering_accepter.foo"
+	peeringName := "ncloud_vpc_peering.foo"
+	name := fmt.Sprintf("test-peering-accept-%s", sdkacctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckVpcPeeringDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudVpcPeeringAccepterConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", peeringName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_vpc_no", peeringName, "source_vpc_no"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_vpc_no", peeringName, "target_vpc_no"),
+					resource.TestCheckResourceAttr(resourceName, "is_between_accounts", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", "RUN"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceNcloudVpcPeeringAccepterConfig(name string) string {
+	return testAccResourceNcloudVpcPeeringConfig(name) + `
+resource "ncloud_vpc_peering_accepter" "foo" {
+	vpc_peering_no = ncloud_vpc_peering.foo.vpc_peering_no
+}
+`
+}
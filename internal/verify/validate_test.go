@@ -197,3 +197,22 @@ func Test_ValidateDateISO8601(t *testing.T) {
 		}
 	}
 }
+
+func Test_CIDRBlocksOverlap(t *testing.T) {
+	cases := []struct {
+		A, B    string
+		Overlap bool
+	}{
+		{A: "10.0.0.0/24", B: "10.0.0.0/24", Overlap: true},
+		{A: "10.0.0.0/16", B: "10.0.3.0/24", Overlap: true},
+		{A: "10.0.3.0/24", B: "10.0.0.0/16", Overlap: true},
+		{A: "10.0.0.0/24", B: "10.0.1.0/24", Overlap: false},
+		{A: "10.0.0.0/25", B: "10.0.0.128/25", Overlap: false},
+		{A: "10.0.0.0/24", B: "invalid", Overlap: false},
+	}
+	for _, tc := range cases {
+		if got := verify.CIDRBlocksOverlap(tc.A, tc.B); got != tc.Overlap {
+			t.Fatalf("CIDRBlocksOverlap(%q, %q) = %t, expected %t", tc.A, tc.B, got, tc.Overlap)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package verifystring
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"golang.org/x/crypto/ssh"
+)
+
+type sshPublicKeyValidator struct{}
+
+// SSHPublicKey validates that the value is a public key in OpenSSH authorized_keys format.
+func SSHPublicKey() validator.String {
+	return sshPublicKeyValidator{}
+}
+
+func (v sshPublicKeyValidator) Description(ctx context.Context) string {
+	return "Value must be a public key in OpenSSH authorized_keys format (e.g. 'ssh-rsa AAAA...')."
+}
+
+func (v sshPublicKeyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v sshPublicKeyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, _, _, _, err := ssh.ParseAuthorizedKey([]byte(req.ConfigValue.ValueString())); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Value",
+			fmt.Sprintf("%s: %s", v.Description(ctx), err),
+		)
+	}
+}